@@ -0,0 +1,295 @@
+// Package cidr contains the address arithmetic shared by the client and the
+// provider. It only depends on net/netip so it can be used from schema code.
+package cidr
+
+import (
+	"fmt"
+	"math/big"
+	"net/netip"
+	"sort"
+)
+
+// Parse parses s as a CIDR and returns the prefix masked to its network address.
+func Parse(s string) (netip.Prefix, error) {
+	prefix, err := netip.ParsePrefix(s)
+	if err != nil {
+		return netip.Prefix{}, fmt.Errorf("invalid CIDR %q: %w", s, err)
+	}
+
+	return prefix.Masked(), nil
+}
+
+// Size returns the number of addresses in prefix.
+func Size(prefix netip.Prefix) *big.Int {
+	return new(big.Int).Lsh(big.NewInt(1), uint(prefix.Addr().BitLen()-prefix.Bits()))
+}
+
+// Count returns the number of addresses in prefixes, which must not overlap.
+func Count(prefixes []netip.Prefix) *big.Int {
+	total := new(big.Int)
+	for _, prefix := range prefixes {
+		total.Add(total, Size(prefix))
+	}
+
+	return total
+}
+
+// Halves splits prefix into its lower and upper halves. It must not be a
+// single address.
+func Halves(prefix netip.Prefix) (netip.Prefix, netip.Prefix) {
+	bits := prefix.Bits() + 1
+	lower := netip.PrefixFrom(prefix.Addr(), bits)
+
+	b := prefix.Addr().AsSlice()
+	b[prefix.Bits()/8] |= 0x80 >> (prefix.Bits() % 8)
+	addr, _ := netip.AddrFromSlice(b)
+
+	return lower, netip.PrefixFrom(addr, bits)
+}
+
+// Free returns the smallest set of prefixes covering the addresses of parent
+// that are not part of any of the used prefixes, in address order.
+func Free(parent netip.Prefix, used []netip.Prefix) []netip.Prefix {
+	overlapping := false
+	for _, u := range used {
+		if !u.Overlaps(parent) {
+			continue
+		}
+		if u.Bits() <= parent.Bits() {
+			return nil
+		}
+		overlapping = true
+	}
+
+	if !overlapping {
+		return []netip.Prefix{parent}
+	}
+
+	lower, upper := Halves(parent)
+
+	return append(Free(lower, used), Free(upper, used)...)
+}
+
+// Version returns the IP version of prefix, 4 or 6.
+func Version(prefix netip.Prefix) int64 {
+	if prefix.Addr().Is4() {
+		return 4
+	}
+
+	return 6
+}
+
+// Contains reports whether every address of inner is part of outer.
+func Contains(outer, inner netip.Prefix) bool {
+	return outer.Bits() <= inner.Bits() && outer.Contains(inner.Addr())
+}
+
+// Last returns the last address of prefix.
+func Last(prefix netip.Prefix) netip.Addr {
+	b := prefix.Masked().Addr().AsSlice()
+	for i := prefix.Bits(); i < len(b)*8; i++ {
+		b[i/8] |= 0x80 >> (i % 8)
+	}
+	addr, _ := netip.AddrFromSlice(b)
+
+	return addr
+}
+
+// Summarize returns the smallest set of prefixes covering exactly the
+// addresses of prefixes, in address order with IPv4 first.
+func Summarize(prefixes []netip.Prefix) []netip.Prefix {
+	sorted := make([]netip.Prefix, len(prefixes))
+	for i, prefix := range prefixes {
+		sorted[i] = prefix.Masked()
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		if c := sorted[i].Addr().Compare(sorted[j].Addr()); c != 0 {
+			return c < 0
+		}
+		return sorted[i].Bits() < sorted[j].Bits()
+	})
+
+	// As the prefixes are sorted, a prefix can only be contained in or be the
+	// upper sibling of the last one kept.
+	summary := []netip.Prefix{}
+	for _, prefix := range sorted {
+		if n := len(summary); n > 0 && Contains(summary[n-1], prefix) {
+			continue
+		}
+		summary = append(summary, prefix)
+
+		for n := len(summary); n >= 2 && siblings(summary[n-2], summary[n-1]); n-- {
+			summary = append(summary[:n-2], netip.PrefixFrom(summary[n-2].Addr(), summary[n-2].Bits()-1))
+		}
+	}
+
+	return summary
+}
+
+// siblings reports whether a and b are the lower and upper halves of the same
+// prefix.
+func siblings(a, b netip.Prefix) bool {
+	if a.Bits() != b.Bits() || a.Bits() == 0 || a == b {
+		return false
+	}
+
+	lower, upper := Halves(netip.PrefixFrom(a.Addr(), a.Bits()-1).Masked())
+
+	return lower == a && upper == b
+}
+
+// Plan packs prefixes of the requested lengths into parent, largest first and
+// in address order, and returns them by name along with the ranges of parent
+// left free. When alignment is not zero every prefix starts on a boundary of a
+// prefix of that length. Prefixes of the same length are placed in name order
+// so that the plan is stable.
+func Plan(parent netip.Prefix, lengths map[string]int, alignment int) (map[string]netip.Prefix, []netip.Prefix, error) {
+	bitLen := parent.Addr().BitLen()
+
+	if alignment != 0 && (alignment < parent.Bits() || alignment > bitLen) {
+		return nil, nil, fmt.Errorf("alignment /%d must be between /%d and /%d", alignment, parent.Bits(), bitLen)
+	}
+
+	names := make([]string, 0, len(lengths))
+	for name, bits := range lengths {
+		if bits < parent.Bits() || bits > bitLen {
+			return nil, nil, fmt.Errorf("size /%d of %q must be between /%d and /%d", bits, name, parent.Bits(), bitLen)
+		}
+		names = append(names, name)
+	}
+	sort.Slice(names, func(i, j int) bool {
+		if lengths[names[i]] != lengths[names[j]] {
+			return lengths[names[i]] < lengths[names[j]]
+		}
+		return names[i] < names[j]
+	})
+
+	plan := make(map[string]netip.Prefix, len(names))
+	used := []netip.Prefix{}
+	free := []netip.Prefix{parent}
+
+	for _, name := range names {
+		bits := lengths[name]
+
+		// The aligned slot is the larger of the prefix and the alignment.
+		slot := bits
+		if alignment != 0 && alignment < slot {
+			slot = alignment
+		}
+
+		i := 0
+		for i < len(free) && free[i].Bits() > slot {
+			i++
+		}
+		if i == len(free) {
+			return nil, nil, fmt.Errorf("no free range left in %s for %q of size /%d", parent, name, bits)
+		}
+
+		taken := netip.PrefixFrom(free[i].Addr(), slot)
+		rest := Free(free[i], []netip.Prefix{taken})
+		free = append(free[:i], append(rest, free[i+1:]...)...)
+
+		plan[name] = netip.PrefixFrom(taken.Addr(), bits)
+		used = append(used, plan[name])
+	}
+
+	return plan, Free(parent, used), nil
+}
+
+// LengthFor returns the prefix length of the smallest prefix holding count
+// addresses, for addresses of bitLen bits. The result is negative when count
+// exceeds the address space.
+func LengthFor(count *big.Int, bitLen int) int {
+	if count.Cmp(big.NewInt(1)) <= 0 {
+		return bitLen
+	}
+
+	return bitLen - new(big.Int).Sub(count, big.NewInt(1)).BitLen()
+}
+
+// Netmask returns the network mask of prefix as an address, such as
+// 255.255.252.0 for a /22.
+func Netmask(prefix netip.Prefix) netip.Addr {
+	ones := make([]byte, prefix.Addr().BitLen()/8)
+	for i := range ones {
+		ones[i] = 0xff
+	}
+	addr, _ := netip.AddrFromSlice(ones)
+
+	return netip.PrefixFrom(addr, prefix.Bits()).Masked().Addr()
+}
+
+// UsableRange returns the first and last usable addresses of prefix. The
+// network address and the IPv4 broadcast address are excluded, except for
+// point-to-point and single address prefixes.
+func UsableRange(prefix netip.Prefix) (netip.Addr, netip.Addr) {
+	first, last := prefix.Masked().Addr(), Last(prefix)
+	if prefix.Bits() >= prefix.Addr().BitLen()-1 {
+		return first, last
+	}
+
+	if prefix.Addr().Is4() {
+		return first.Next(), last.Prev()
+	}
+
+	return first.Next(), last
+}
+
+// Within returns the parts of prefixes that lie within outer.
+func Within(prefixes []netip.Prefix, outer netip.Prefix) []netip.Prefix {
+	var within []netip.Prefix
+	for _, prefix := range prefixes {
+		switch {
+		case Contains(outer, prefix):
+			within = append(within, prefix)
+		case Contains(prefix, outer):
+			within = append(within, outer)
+		}
+	}
+
+	return within
+}
+
+// Exclude returns the parts of prefixes that do not overlap any of excluded.
+func Exclude(prefixes, excluded []netip.Prefix) []netip.Prefix {
+	var remaining []netip.Prefix
+	for _, prefix := range prefixes {
+		remaining = append(remaining, Free(prefix, excluded)...)
+	}
+
+	return remaining
+}
+
+// Pick returns a prefix of length bits from free, which must be in address
+// order. By default the lowest fitting prefix is returned; highest returns the
+// highest one instead, and smallest prefers the smallest free range holding
+// it, like the engine's reverse_search and smallest_cidr options.
+func Pick(free []netip.Prefix, bits int, highest, smallest bool) (netip.Prefix, bool) {
+	found := -1
+	for i, prefix := range free {
+		if prefix.Bits() > bits {
+			continue
+		}
+
+		switch {
+		case found < 0:
+			found = i
+		case smallest && prefix.Bits() != free[found].Bits():
+			if prefix.Bits() > free[found].Bits() {
+				found = i
+			}
+		case highest:
+			found = i
+		}
+	}
+
+	if found < 0 {
+		return netip.Prefix{}, false
+	}
+
+	if highest {
+		return netip.PrefixFrom(Last(free[found]), bits).Masked(), true
+	}
+
+	return netip.PrefixFrom(free[found].Addr(), bits), true
+}
@@ -0,0 +1,488 @@
+package cidr
+
+import (
+	"math/big"
+	"net/netip"
+	"strings"
+	"testing"
+)
+
+// prefixes parses a space separated list of CIDRs.
+func prefixes(t *testing.T, s string) []netip.Prefix {
+	t.Helper()
+
+	var result []netip.Prefix
+	for _, v := range strings.Fields(s) {
+		result = append(result, netip.MustParsePrefix(v))
+	}
+
+	return result
+}
+
+// join formats prefixes the way the test cases list them.
+func join(prefixes []netip.Prefix) string {
+	s := make([]string, len(prefixes))
+	for i, prefix := range prefixes {
+		s[i] = prefix.String()
+	}
+
+	return strings.Join(s, " ")
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    string
+		wantErr bool
+	}{
+		{in: "10.0.0.0/24", want: "10.0.0.0/24"},
+		{in: "10.0.0.17/24", want: "10.0.0.0/24"},
+		{in: "0.0.0.0/0", want: "0.0.0.0/0"},
+		{in: "10.0.0.1/32", want: "10.0.0.1/32"},
+		{in: "fd00::1/64", want: "fd00::/64"},
+		{in: "::/0", want: "::/0"},
+		{in: "fd00::1/128", want: "fd00::1/128"},
+		{in: "10.0.0.0/33", wantErr: true},
+		{in: "10.0.0.0", wantErr: true},
+		{in: "", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		got, err := Parse(tt.in)
+		if tt.wantErr {
+			if err == nil {
+				t.Errorf("Parse(%q) = %s, want an error", tt.in, got)
+			}
+			continue
+		}
+		if err != nil || got.String() != tt.want {
+			t.Errorf("Parse(%q) = %s, %v, want %s", tt.in, got, err, tt.want)
+		}
+	}
+}
+
+func TestSizeAndCount(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{in: "10.0.0.0/24", want: "256"},
+		{in: "10.0.0.1/32", want: "1"},
+		{in: "0.0.0.0/0", want: "4294967296"},
+		{in: "fd00::/64", want: "18446744073709551616"},
+		{in: "fd00::1/128", want: "1"},
+		{in: "::/0", want: "340282366920938463463374607431768211456"},
+		{in: "10.0.0.0/24 10.0.1.0/25 10.0.2.0/32", want: "385"},
+		{in: "", want: "0"},
+	}
+
+	for _, tt := range tests {
+		ps := prefixes(t, tt.in)
+
+		if got := Count(ps).String(); got != tt.want {
+			t.Errorf("Count(%s) = %s, want %s", tt.in, got, tt.want)
+		}
+
+		if len(ps) == 1 {
+			if got := Size(ps[0]).String(); got != tt.want {
+				t.Errorf("Size(%s) = %s, want %s", tt.in, got, tt.want)
+			}
+		}
+	}
+}
+
+func TestHalves(t *testing.T) {
+	tests := []struct {
+		in, lower, upper string
+	}{
+		{in: "10.0.0.0/24", lower: "10.0.0.0/25", upper: "10.0.0.128/25"},
+		{in: "10.0.0.0/31", lower: "10.0.0.0/32", upper: "10.0.0.1/32"},
+		{in: "0.0.0.0/0", lower: "0.0.0.0/1", upper: "128.0.0.0/1"},
+		{in: "fd00::/64", lower: "fd00::/65", upper: "fd00::8000:0:0:0/65"},
+		{in: "::/0", lower: "::/1", upper: "8000::/1"},
+		{in: "fd00::/127", lower: "fd00::/128", upper: "fd00::1/128"},
+	}
+
+	for _, tt := range tests {
+		lower, upper := Halves(netip.MustParsePrefix(tt.in))
+		if lower.String() != tt.lower || upper.String() != tt.upper {
+			t.Errorf("Halves(%s) = %s, %s, want %s, %s", tt.in, lower, upper, tt.lower, tt.upper)
+		}
+	}
+}
+
+func TestFree(t *testing.T) {
+	tests := []struct {
+		name   string
+		parent string
+		used   string
+		want   string
+	}{
+		{name: "nothing used", parent: "10.0.0.0/24", want: "10.0.0.0/24"},
+		{name: "unrelated", parent: "10.0.0.0/24", used: "10.1.0.0/16 fd00::/64", want: "10.0.0.0/24"},
+		{name: "lower half", parent: "10.0.0.0/24", used: "10.0.0.0/25", want: "10.0.0.128/25"},
+		{name: "upper half", parent: "10.0.0.0/24", used: "10.0.0.128/25", want: "10.0.0.0/25"},
+		{name: "fully used", parent: "10.0.0.0/24", used: "10.0.0.0/24", want: ""},
+		{name: "used by a larger prefix", parent: "10.0.0.0/24", used: "10.0.0.0/16", want: ""},
+		{name: "touching", parent: "10.0.0.0/24", used: "10.0.0.0/26 10.0.0.64/26", want: "10.0.0.128/25"},
+		{name: "nested", parent: "10.0.0.0/24", used: "10.0.0.0/25 10.0.0.0/26", want: "10.0.0.128/25"},
+		{name: "single address", parent: "10.0.0.0/30", used: "10.0.0.1/32", want: "10.0.0.0/32 10.0.0.2/31"},
+		{name: "host prefix free", parent: "10.0.0.1/32", want: "10.0.0.1/32"},
+		{name: "host prefix used", parent: "10.0.0.1/32", used: "10.0.0.1/32", want: ""},
+		{
+			name:   "whole address space",
+			parent: "0.0.0.0/0",
+			used:   "10.0.0.0/8",
+			want:   "0.0.0.0/5 8.0.0.0/7 11.0.0.0/8 12.0.0.0/6 16.0.0.0/4 32.0.0.0/3 64.0.0.0/2 128.0.0.0/1",
+		},
+		{name: "IPv6", parent: "fd00::/62", used: "fd00:0:0:1::/64", want: "fd00::/64 fd00:0:0:2::/63"},
+		{name: "IPv6 host", parent: "fd00::/126", used: "fd00::3/128", want: "fd00::/127 fd00::2/128"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := join(Free(netip.MustParsePrefix(tt.parent), prefixes(t, tt.used)))
+			if got != tt.want {
+				t.Errorf("Free(%s, %s) = %q, want %q", tt.parent, tt.used, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestContains(t *testing.T) {
+	tests := []struct {
+		outer, inner string
+		want         bool
+	}{
+		{outer: "10.0.0.0/16", inner: "10.0.1.0/24", want: true},
+		{outer: "10.0.0.0/24", inner: "10.0.0.0/24", want: true},
+		{outer: "10.0.0.0/24", inner: "10.0.0.0/16", want: false},
+		{outer: "10.0.0.0/24", inner: "10.0.1.0/24", want: false},
+		{outer: "0.0.0.0/0", inner: "10.0.0.1/32", want: true},
+		{outer: "10.0.0.1/32", inner: "10.0.0.1/32", want: true},
+		{outer: "::/0", inner: "fd00::1/128", want: true},
+		{outer: "::/0", inner: "10.0.0.0/8", want: false},
+		{outer: "0.0.0.0/0", inner: "fd00::/64", want: false},
+	}
+
+	for _, tt := range tests {
+		if got := Contains(netip.MustParsePrefix(tt.outer), netip.MustParsePrefix(tt.inner)); got != tt.want {
+			t.Errorf("Contains(%s, %s) = %t, want %t", tt.outer, tt.inner, got, tt.want)
+		}
+	}
+}
+
+func TestVersionAndLast(t *testing.T) {
+	tests := []struct {
+		in      string
+		version int64
+		last    string
+	}{
+		{in: "10.0.0.0/24", version: 4, last: "10.0.0.255"},
+		{in: "10.0.0.1/32", version: 4, last: "10.0.0.1"},
+		{in: "0.0.0.0/0", version: 4, last: "255.255.255.255"},
+		{in: "fd00::/64", version: 6, last: "fd00::ffff:ffff:ffff:ffff"},
+		{in: "fd00::1/128", version: 6, last: "fd00::1"},
+		{in: "::/0", version: 6, last: "ffff:ffff:ffff:ffff:ffff:ffff:ffff:ffff"},
+	}
+
+	for _, tt := range tests {
+		prefix := netip.MustParsePrefix(tt.in)
+		if got := Version(prefix); got != tt.version {
+			t.Errorf("Version(%s) = %d, want %d", tt.in, got, tt.version)
+		}
+		if got := Last(prefix).String(); got != tt.last {
+			t.Errorf("Last(%s) = %s, want %s", tt.in, got, tt.last)
+		}
+	}
+}
+
+func TestSummarize(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{name: "empty", in: "", want: ""},
+		{name: "single", in: "10.0.0.0/24", want: "10.0.0.0/24"},
+		{name: "siblings", in: "10.0.0.0/25 10.0.0.128/25", want: "10.0.0.0/24"},
+		{name: "unsorted siblings", in: "10.0.0.128/25 10.0.0.0/25", want: "10.0.0.0/24"},
+		{name: "touching but not siblings", in: "10.0.1.0/24 10.0.2.0/24", want: "10.0.1.0/24 10.0.2.0/24"},
+		{name: "nested", in: "10.0.0.0/24 10.0.0.0/16 10.0.5.0/24", want: "10.0.0.0/16"},
+		{name: "duplicates", in: "10.0.0.0/24 10.0.0.0/24", want: "10.0.0.0/24"},
+		{name: "cascading", in: "10.0.0.0/26 10.0.0.64/26 10.0.0.128/25", want: "10.0.0.0/24"},
+		{name: "whole address space", in: "0.0.0.0/1 128.0.0.0/1", want: "0.0.0.0/0"},
+		{name: "host prefixes", in: "10.0.0.0/32 10.0.0.1/32 10.0.0.3/32", want: "10.0.0.0/31 10.0.0.3/32"},
+		{name: "unmasked", in: "10.0.0.7/24", want: "10.0.0.0/24"},
+		{name: "IPv6", in: "fd00::/65 fd00::8000:0:0:0/65", want: "fd00::/64"},
+		{name: "IPv6 hosts", in: "fd00::/128 fd00::1/128", want: "fd00::/127"},
+		{name: "IPv4 first", in: "fd00::/64 10.0.0.0/24", want: "10.0.0.0/24 fd00::/64"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := join(Summarize(prefixes(t, tt.in))); got != tt.want {
+				t.Errorf("Summarize(%s) = %q, want %q", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPlan(t *testing.T) {
+	tests := []struct {
+		name      string
+		parent    string
+		lengths   map[string]int
+		alignment int
+		want      map[string]string
+		free      string
+		wantErr   bool
+	}{
+		{
+			name:    "largest first",
+			parent:  "10.0.0.0/24",
+			lengths: map[string]int{"a": 26, "b": 25},
+			want:    map[string]string{"b": "10.0.0.0/25", "a": "10.0.0.128/26"},
+			free:    "10.0.0.192/26",
+		},
+		{
+			name:    "ties in name order",
+			parent:  "10.0.0.0/24",
+			lengths: map[string]int{"b": 26, "a": 26},
+			want:    map[string]string{"a": "10.0.0.0/26", "b": "10.0.0.64/26"},
+			free:    "10.0.0.128/25",
+		},
+		{
+			name:    "exact fit",
+			parent:  "10.0.0.0/24",
+			lengths: map[string]int{"a": 25, "b": 25},
+			want:    map[string]string{"a": "10.0.0.0/25", "b": "10.0.0.128/25"},
+			free:    "",
+		},
+		{
+			name:      "aligned",
+			parent:    "10.0.0.0/24",
+			lengths:   map[string]int{"a": 28, "b": 28},
+			alignment: 26,
+			want:      map[string]string{"a": "10.0.0.0/28", "b": "10.0.0.64/28"},
+			free:      "10.0.0.16/28 10.0.0.32/27 10.0.0.80/28 10.0.0.96/27 10.0.0.128/25",
+		},
+		{
+			name:    "host prefixes",
+			parent:  "10.0.0.0/31",
+			lengths: map[string]int{"a": 32, "b": 32},
+			want:    map[string]string{"a": "10.0.0.0/32", "b": "10.0.0.1/32"},
+			free:    "",
+		},
+		{
+			name:    "whole address space",
+			parent:  "0.0.0.0/0",
+			lengths: map[string]int{"a": 1},
+			want:    map[string]string{"a": "0.0.0.0/1"},
+			free:    "128.0.0.0/1",
+		},
+		{
+			name:    "IPv6",
+			parent:  "fd00::/56",
+			lengths: map[string]int{"a": 64, "b": 60},
+			want:    map[string]string{"b": "fd00::/60", "a": "fd00:0:0:10::/64"},
+			free:    "fd00:0:0:11::/64 fd00:0:0:12::/63 fd00:0:0:14::/62 fd00:0:0:18::/61 fd00:0:0:20::/59 fd00:0:0:40::/58 fd00:0:0:80::/57",
+		},
+		{name: "empty", parent: "10.0.0.0/24", lengths: map[string]int{}, want: map[string]string{}, free: "10.0.0.0/24"},
+		{name: "does not fit", parent: "10.0.0.0/24", lengths: map[string]int{"a": 25, "b": 25, "c": 26}, wantErr: true},
+		{name: "larger than parent", parent: "10.0.0.0/24", lengths: map[string]int{"a": 23}, wantErr: true},
+		{name: "longer than address", parent: "10.0.0.0/24", lengths: map[string]int{"a": 33}, wantErr: true},
+		{name: "alignment too large", parent: "10.0.0.0/24", lengths: map[string]int{"a": 26}, alignment: 20, wantErr: true},
+		{name: "alignment too long", parent: "fd00::/64", lengths: map[string]int{"a": 72}, alignment: 129, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			plan, free, err := Plan(netip.MustParsePrefix(tt.parent), tt.lengths, tt.alignment)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("Plan() = %v, want an error", plan)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Plan() error = %v", err)
+			}
+
+			if len(plan) != len(tt.want) {
+				t.Errorf("Plan() = %v, want %v", plan, tt.want)
+			}
+			for name, want := range tt.want {
+				if got := plan[name].String(); got != want {
+					t.Errorf("Plan()[%q] = %s, want %s", name, got, want)
+				}
+			}
+
+			if got := join(free); got != tt.free {
+				t.Errorf("Plan() free = %q, want %q", got, tt.free)
+			}
+		})
+	}
+}
+
+func TestLengthFor(t *testing.T) {
+	tests := []struct {
+		count  string
+		bitLen int
+		want   int
+	}{
+		{count: "0", bitLen: 32, want: 32},
+		{count: "1", bitLen: 32, want: 32},
+		{count: "2", bitLen: 32, want: 31},
+		{count: "3", bitLen: 32, want: 30},
+		{count: "256", bitLen: 32, want: 24},
+		{count: "257", bitLen: 32, want: 23},
+		{count: "4294967296", bitLen: 32, want: 0},
+		{count: "4294967297", bitLen: 32, want: -1},
+		{count: "18446744073709551616", bitLen: 128, want: 64},
+		{count: "18446744073709551617", bitLen: 128, want: 63},
+		{count: "340282366920938463463374607431768211456", bitLen: 128, want: 0},
+		{count: "1", bitLen: 128, want: 128},
+	}
+
+	for _, tt := range tests {
+		count, _ := new(big.Int).SetString(tt.count, 10)
+		if got := LengthFor(count, tt.bitLen); got != tt.want {
+			t.Errorf("LengthFor(%s, %d) = %d, want %d", tt.count, tt.bitLen, got, tt.want)
+		}
+	}
+}
+
+func TestNetmask(t *testing.T) {
+	tests := []struct {
+		in, want string
+	}{
+		{in: "10.0.0.0/22", want: "255.255.252.0"},
+		{in: "10.0.0.0/24", want: "255.255.255.0"},
+		{in: "0.0.0.0/0", want: "0.0.0.0"},
+		{in: "10.0.0.1/32", want: "255.255.255.255"},
+		{in: "fd00::/64", want: "ffff:ffff:ffff:ffff::"},
+		{in: "::/0", want: "::"},
+		{in: "fd00::1/128", want: "ffff:ffff:ffff:ffff:ffff:ffff:ffff:ffff"},
+	}
+
+	for _, tt := range tests {
+		if got := Netmask(netip.MustParsePrefix(tt.in)).String(); got != tt.want {
+			t.Errorf("Netmask(%s) = %s, want %s", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestUsableRange(t *testing.T) {
+	tests := []struct {
+		in, first, last string
+	}{
+		{in: "10.0.0.0/24", first: "10.0.0.1", last: "10.0.0.254"},
+		{in: "10.0.0.0/30", first: "10.0.0.1", last: "10.0.0.2"},
+		{in: "10.0.0.0/31", first: "10.0.0.0", last: "10.0.0.1"},
+		{in: "10.0.0.1/32", first: "10.0.0.1", last: "10.0.0.1"},
+		{in: "0.0.0.0/0", first: "0.0.0.1", last: "255.255.255.254"},
+		{in: "fd00::/64", first: "fd00::1", last: "fd00::ffff:ffff:ffff:ffff"},
+		{in: "fd00::/127", first: "fd00::", last: "fd00::1"},
+		{in: "fd00::1/128", first: "fd00::1", last: "fd00::1"},
+		{in: "::/0", first: "::1", last: "ffff:ffff:ffff:ffff:ffff:ffff:ffff:ffff"},
+	}
+
+	for _, tt := range tests {
+		first, last := UsableRange(netip.MustParsePrefix(tt.in))
+		if first.String() != tt.first || last.String() != tt.last {
+			t.Errorf("UsableRange(%s) = %s, %s, want %s, %s", tt.in, first, last, tt.first, tt.last)
+		}
+	}
+}
+
+func TestWithin(t *testing.T) {
+	tests := []struct {
+		name     string
+		prefixes string
+		outer    string
+		want     string
+	}{
+		{name: "contained", prefixes: "10.0.1.0/24 10.0.2.0/25", outer: "10.0.0.0/16", want: "10.0.1.0/24 10.0.2.0/25"},
+		{name: "containing", prefixes: "10.0.0.0/16", outer: "10.0.1.0/24", want: "10.0.1.0/24"},
+		{name: "equal", prefixes: "10.0.0.0/24", outer: "10.0.0.0/24", want: "10.0.0.0/24"},
+		{name: "touching", prefixes: "10.0.0.0/24 10.0.2.0/24", outer: "10.0.1.0/24", want: ""},
+		{name: "mixed", prefixes: "10.0.0.0/24 10.1.0.0/24", outer: "10.1.0.0/16", want: "10.1.0.0/24"},
+		{name: "whole address space", prefixes: "10.0.0.0/8 fd00::/64", outer: "0.0.0.0/0", want: "10.0.0.0/8"},
+		{name: "host", prefixes: "10.0.0.0/24", outer: "10.0.0.7/32", want: "10.0.0.7/32"},
+		{name: "IPv6", prefixes: "fd00::/48", outer: "fd00:0:0:5::/64", want: "fd00:0:0:5::/64"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := join(Within(prefixes(t, tt.prefixes), netip.MustParsePrefix(tt.outer))); got != tt.want {
+				t.Errorf("Within(%s, %s) = %q, want %q", tt.prefixes, tt.outer, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestExclude(t *testing.T) {
+	tests := []struct {
+		name     string
+		prefixes string
+		excluded string
+		want     string
+	}{
+		{name: "nothing excluded", prefixes: "10.0.0.0/24", want: "10.0.0.0/24"},
+		{name: "half", prefixes: "10.0.0.0/24", excluded: "10.0.0.0/25", want: "10.0.0.128/25"},
+		{name: "covering", prefixes: "10.0.0.0/24 10.0.1.0/24", excluded: "10.0.0.0/16", want: ""},
+		{name: "touching", prefixes: "10.0.0.0/24", excluded: "10.0.1.0/24", want: "10.0.0.0/24"},
+		{name: "several", prefixes: "10.0.0.0/24 10.0.1.0/24", excluded: "10.0.0.128/25 10.0.1.0/25", want: "10.0.0.0/25 10.0.1.128/25"},
+		{name: "host", prefixes: "10.0.0.0/31", excluded: "10.0.0.1/32", want: "10.0.0.0/32"},
+		{name: "IPv6", prefixes: "fd00::/63", excluded: "fd00::/64", want: "fd00:0:0:1::/64"},
+		{name: "other version", prefixes: "10.0.0.0/24", excluded: "::/0", want: "10.0.0.0/24"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := join(Exclude(prefixes(t, tt.prefixes), prefixes(t, tt.excluded))); got != tt.want {
+				t.Errorf("Exclude(%s, %s) = %q, want %q", tt.prefixes, tt.excluded, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPick(t *testing.T) {
+	free := "10.0.0.0/26 10.0.0.128/28 10.0.1.0/24"
+
+	tests := []struct {
+		name      string
+		free      string
+		bits      int
+		highest   bool
+		smallest  bool
+		want      string
+		wantFound bool
+	}{
+		{name: "lowest", free: free, bits: 28, want: "10.0.0.0/28", wantFound: true},
+		{name: "highest", free: free, bits: 28, highest: true, want: "10.0.1.240/28", wantFound: true},
+		{name: "smallest", free: free, bits: 28, smallest: true, want: "10.0.0.128/28", wantFound: true},
+		{name: "smallest highest", free: free, bits: 27, smallest: true, highest: true, want: "10.0.0.32/27", wantFound: true},
+		{name: "only the largest fits", free: free, bits: 25, want: "10.0.1.0/25", wantFound: true},
+		{name: "exact", free: free, bits: 24, want: "10.0.1.0/24", wantFound: true},
+		{name: "too large", free: free, bits: 23},
+		{name: "nothing free", free: "", bits: 24},
+		{name: "host", free: "10.0.0.5/32", bits: 32, want: "10.0.0.5/32", wantFound: true},
+		{name: "whole address space", free: "0.0.0.0/0", bits: 0, highest: true, want: "0.0.0.0/0", wantFound: true},
+		{name: "IPv6", free: "fd00::/56", bits: 64, highest: true, want: "fd00:0:0:ff::/64", wantFound: true},
+		{name: "IPv6 host", free: "fd00::/127", bits: 128, highest: true, want: "fd00::1/128", wantFound: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, found := Pick(prefixes(t, tt.free), tt.bits, tt.highest, tt.smallest)
+			if found != tt.wantFound {
+				t.Fatalf("Pick() found = %t, want %t", found, tt.wantFound)
+			}
+			if found && got.String() != tt.want {
+				t.Errorf("Pick() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
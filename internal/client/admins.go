@@ -5,7 +5,9 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"strings"
 	"terraform-provider-azureipam/internal/gen/data_sources"
+	"terraform-provider-azureipam/internal/gen/resources"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -90,3 +92,240 @@ func (c *Client) AdminsApiGet(ctx context.Context, data *data_sources.AdminsMode
 
 	return diags
 }
+
+// AdminsApiPut replaces the list of admins with the admins of the model. The
+// model is left as planned; the engine's spelling is only picked up on read.
+func (c *Client) AdminsApiPut(ctx context.Context, data *resources.AdminsModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var admins []resources.AdminsValue
+	diags.Append(data.Admins.ElementsAs(ctx, &admins, false)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	payload := make([]adminsApiModel, len(admins))
+	for i, admin := range admins {
+		payload[i] = adminsApiModel{
+			ID:    admin.Id.ValueString(),
+			Name:  admin.Name.ValueString(),
+			Email: admin.Email.ValueString(),
+			Type:  admin.AdminsType.ValueString(),
+		}
+	}
+
+	if err := c.doJSON(ctx, "PUT", fmt.Sprintf("%s/api/admin/admins", c.HostURL), payload, nil); err != nil {
+		diags.AddError("API Request Error", fmt.Sprintf("API request failed: %s", err))
+	}
+
+	return diags
+}
+
+// AdminsResourceApiGet retrieves the list of admins and maps them to the
+// resource model, so that admins added or removed outside of Terraform show
+// up as drift. Admins already in the model keep their spelling when the
+// engine only differs in case, and keep a null email address the engine
+// filled in, so that normalisation by the engine is not reported as drift.
+func (c *Client) AdminsResourceApiGet(ctx context.Context, data *resources.AdminsModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	response, err := c.adminsGet(ctx)
+	if err != nil {
+		diags.AddError("API Request Error", fmt.Sprintf("API request failed: %s", err))
+		return diags
+	}
+
+	var current []resources.AdminsValue
+	if !data.Admins.IsNull() && !data.Admins.IsUnknown() {
+		diags.Append(data.Admins.ElementsAs(ctx, &current, false)...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	elements := make([]attr.Value, len(response))
+	for i, admin := range response {
+		// Principals have no email address.
+		email := types.StringNull()
+		if admin.Email != "" {
+			email = types.StringValue(admin.Email)
+		}
+
+		for _, known := range current {
+			if !strings.EqualFold(known.Id.ValueString(), admin.ID) {
+				continue
+			}
+
+			admin.ID = known.Id.ValueString()
+			admin.Name = keepSpelling(known.Name.ValueString(), admin.Name)
+			admin.Type = keepSpelling(known.AdminsType.ValueString(), admin.Type)
+			email = keepEmail(known.Email, admin.Email)
+			break
+		}
+
+		objVal, objDiags := resources.NewAdminsValue(resources.NewAdminsValueNull().AttributeTypes(ctx),
+			map[string]attr.Value{
+				"id":    types.StringValue(admin.ID),
+				"name":  types.StringValue(admin.Name),
+				"email": email,
+				"type":  types.StringValue(admin.Type),
+			},
+		)
+		diags.Append(objDiags...)
+		if diags.HasError() {
+			return diags
+		}
+		elements[i] = objVal
+	}
+
+	adminsSet, setDiags := types.SetValue(resources.NewAdminsValueNull().Type(ctx), elements)
+	diags.Append(setDiags...)
+	if diags.HasError() {
+		return diags
+	}
+
+	data.Admins = adminsSet
+
+	return diags
+}
+
+// adminsGet retrieves the list of admins.
+func (c *Client) adminsGet(ctx context.Context) ([]adminsApiModel, error) {
+	var response []adminsApiModel
+
+	if err := c.doJSON(ctx, "GET", fmt.Sprintf("%s/api/admin/admins", c.HostURL), nil, &response); err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// AdminApiPost adds a single admin to the list of admins.
+func (c *Client) AdminApiPost(ctx context.Context, data *resources.AdminModel) diag.Diagnostics {
+	admin := adminFromModel(data)
+
+	return modifyList(ctx, c, fmt.Sprintf("%s/api/admin/admins", c.HostURL), "Admins", func(admins []adminsApiModel) ([]adminsApiModel, error) {
+		if i := findAdmin(admins, admin.ID); i >= 0 {
+			return nil, fmt.Errorf("admin %s already exists, import it to manage it with Terraform", admin.ID)
+		}
+
+		return append(admins, admin), nil
+	}, func(admins []adminsApiModel) bool {
+		return findAdmin(admins, admin.ID) >= 0
+	})
+}
+
+// AdminApiGet refreshes a single admin. It reports false when the admin is no
+// longer in the list of admins. As for the admins resource, the model keeps
+// its spelling where the engine only differs in case and keeps a null email
+// address the engine filled in.
+func (c *Client) AdminApiGet(ctx context.Context, data *resources.AdminModel) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	admins, err := c.adminsGet(ctx)
+	if err != nil {
+		diags.AddError("API Request Error", fmt.Sprintf("API request failed: %s", err))
+		return false, diags
+	}
+
+	i := findAdmin(admins, data.Id.ValueString())
+	if i < 0 {
+		return false, diags
+	}
+
+	data.Name = types.StringValue(keepSpelling(data.Name.ValueString(), admins[i].Name))
+	data.Type = types.StringValue(keepSpelling(data.Type.ValueString(), admins[i].Type))
+	data.Email = keepEmail(data.Email, admins[i].Email)
+
+	return true, diags
+}
+
+// AdminApiPut updates a single admin in the list of admins.
+func (c *Client) AdminApiPut(ctx context.Context, data *resources.AdminModel) diag.Diagnostics {
+	admin := adminFromModel(data)
+
+	return modifyList(ctx, c, fmt.Sprintf("%s/api/admin/admins", c.HostURL), "Admins", func(admins []adminsApiModel) ([]adminsApiModel, error) {
+		i := findAdmin(admins, admin.ID)
+		if i < 0 {
+			return nil, fmt.Errorf("admin %s no longer exists", admin.ID)
+		}
+
+		admins[i] = admin
+		return admins, nil
+	}, func(admins []adminsApiModel) bool {
+		i := findAdmin(admins, admin.ID)
+		return i >= 0 && sameAdmin(admins[i], admin)
+	})
+}
+
+// AdminApiDelete removes a single admin from the list of admins. Removing an
+// admin that no longer exists is not an error.
+func (c *Client) AdminApiDelete(ctx context.Context, data *resources.AdminModel) diag.Diagnostics {
+	id := data.Id.ValueString()
+
+	return modifyList(ctx, c, fmt.Sprintf("%s/api/admin/admins", c.HostURL), "Admins", func(admins []adminsApiModel) ([]adminsApiModel, error) {
+		i := findAdmin(admins, id)
+		if i < 0 {
+			return admins, nil
+		}
+
+		return append(admins[:i], admins[i+1:]...), nil
+	}, func(admins []adminsApiModel) bool {
+		return findAdmin(admins, id) < 0
+	})
+}
+
+func adminFromModel(data *resources.AdminModel) adminsApiModel {
+	return adminsApiModel{
+		ID:    data.Id.ValueString(),
+		Name:  data.Name.ValueString(),
+		Email: data.Email.ValueString(),
+		Type:  data.Type.ValueString(),
+	}
+}
+
+// sameAdmin reports whether a and b describe the same admin, ignoring the
+// case the engine may normalise.
+func sameAdmin(a, b adminsApiModel) bool {
+	return strings.EqualFold(a.ID, b.ID) &&
+		strings.EqualFold(a.Name, b.Name) &&
+		strings.EqualFold(a.Email, b.Email) &&
+		strings.EqualFold(a.Type, b.Type)
+}
+
+// findAdmin returns the index of the admin with the given object ID, or -1.
+// Object IDs are GUIDs and compared case-insensitively.
+// keepSpelling returns known when the engine's value only differs from it in
+// case, and the engine's value otherwise.
+func keepSpelling(known, engine string) string {
+	if strings.EqualFold(known, engine) {
+		return known
+	}
+
+	return engine
+}
+
+// keepEmail returns the email address of an admin as the engine reports it,
+// unless the model already holds it in another case or leaves it null.
+// Principals have no email address.
+func keepEmail(known types.String, engine string) types.String {
+	if known.IsNull() || strings.EqualFold(known.ValueString(), engine) {
+		return known
+	}
+
+	if engine == "" {
+		return types.StringNull()
+	}
+
+	return types.StringValue(engine)
+}
+
+func findAdmin(admins []adminsApiModel, id string) int {
+	for i, admin := range admins {
+		if strings.EqualFold(admin.ID, id) {
+			return i
+		}
+	}
+
+	return -1
+}
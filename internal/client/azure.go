@@ -0,0 +1,396 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/netip"
+	"regexp"
+	"sort"
+	"strings"
+	"terraform-provider-azureipam/internal/cidr"
+	"terraform-provider-azureipam/internal/gen/data_sources"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// vnetApiModel is a Virtual Network discovered by the engine.
+type vnetApiModel struct {
+	Name           string   `json:"name"`
+	Id             string   `json:"id"`
+	Prefixes       []string `json:"prefixes"`
+	ResourceGroup  string   `json:"resource_group"`
+	SubscriptionId string   `json:"subscription_id"`
+	TenantId       string   `json:"tenant_id"`
+	Size           int64    `json:"size"`
+	Used           int64    `json:"used"`
+	ParentSpace    string   `json:"parent_space"`
+	ParentBlock    string   `json:"parent_block"`
+}
+
+// subnetApiModel is a Subnet discovered by the engine.
+type subnetApiModel struct {
+	Name           string `json:"name"`
+	Id             string `json:"id"`
+	Prefix         string `json:"prefix"`
+	ResourceGroup  string `json:"resource_group"`
+	SubscriptionId string `json:"subscription_id"`
+	VnetName       string `json:"vnet_name"`
+	VnetId         string `json:"vnet_id"`
+	Size           int64  `json:"size"`
+	Used           int64  `json:"used"`
+}
+
+// endpointApiModel is a Private Endpoint discovered by the engine.
+type endpointApiModel struct {
+	Name           string `json:"name"`
+	Id             string `json:"id"`
+	PrivateIp      string `json:"private_ip"`
+	ResourceGroup  string `json:"resource_group"`
+	SubscriptionId string `json:"subscription_id"`
+	VnetName       string `json:"vnet_name"`
+	VnetId         string `json:"vnet_id"`
+	SubnetName     string `json:"subnet_name"`
+	SubnetId       string `json:"subnet_id"`
+}
+
+// subscriptionApiModel is a subscription visible to the engine.
+type subscriptionApiModel struct {
+	Name           string `json:"name"`
+	SubscriptionId string `json:"subscription_id"`
+	TenantId       string `json:"tenant_id"`
+	Type           string `json:"type"`
+	MgId           string `json:"mg_id"`
+	MgName         string `json:"mg_name"`
+}
+
+// AzureVnetsApiGet retrieves the Virtual Networks discovered by the engine
+// that match every filter set in the model. Subscription IDs and resource
+// groups are compared case-insensitively, like Azure does.
+func (c *Client) AzureVnetsApiGet(ctx context.Context, data *data_sources.AzureVnetsModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	response, err := c.azureVnetsGet(ctx)
+	if err != nil {
+		diags.AddError("API Request Error", fmt.Sprintf("API request failed: %s", err))
+		return diags
+	}
+
+	sort.SliceStable(response, func(i, j int) bool {
+		return strings.ToLower(response[i].Id) < strings.ToLower(response[j].Id)
+	})
+
+	elements := []attr.Value{}
+	for _, vnet := range response {
+		if !matchesFold(data.SubscriptionId, vnet.SubscriptionId) ||
+			!matchesFold(data.ResourceGroup, vnet.ResourceGroup) ||
+			!matches(data.Space, vnet.ParentSpace) ||
+			!matches(data.Block, vnet.ParentBlock) {
+			continue
+		}
+
+		if !data.ContainsCidr.IsNull() {
+			contains, err := prefixesContain(vnet.Prefixes, data.ContainsCidr.ValueString())
+			if err != nil {
+				diags.AddAttributeError(path.Root("contains_cidr"), "Invalid CIDR", err.Error())
+				return diags
+			}
+			if !contains {
+				continue
+			}
+		}
+
+		prefixes, listDiags := types.ListValueFrom(ctx, types.StringType, vnet.Prefixes)
+		diags.Append(listDiags...)
+		if diags.HasError() {
+			return diags
+		}
+
+		objVal, objDiags := data_sources.NewVnetsValue(data_sources.NewVnetsValueNull().AttributeTypes(ctx),
+			map[string]attr.Value{
+				"id":              types.StringValue(vnet.Id),
+				"name":            types.StringValue(vnet.Name),
+				"subscription_id": types.StringValue(vnet.SubscriptionId),
+				"resource_group":  types.StringValue(vnet.ResourceGroup),
+				"tenant_id":       types.StringValue(vnet.TenantId),
+				"prefixes":        prefixes,
+				"size":            types.Int64Value(vnet.Size),
+				"used":            types.Int64Value(vnet.Used),
+				"parent_space":    stringValueOrNull(vnet.ParentSpace),
+				"parent_block":    stringValueOrNull(vnet.ParentBlock),
+			},
+		)
+		diags.Append(objDiags...)
+		if diags.HasError() {
+			return diags
+		}
+		elements = append(elements, objVal)
+	}
+
+	vnetsList, listDiags := types.ListValue(data_sources.NewVnetsValueNull().Type(ctx), elements)
+	diags.Append(listDiags...)
+	if diags.HasError() {
+		return diags
+	}
+
+	data.Vnets = vnetsList
+
+	return diags
+}
+
+// AzureSubnetsApiGet retrieves the Subnets discovered by the engine, optionally
+// limited to a Virtual Network or to Subnets with enough free addresses. The
+// Subnets are sorted by resource ID so that the list is stable between runs.
+func (c *Client) AzureSubnetsApiGet(ctx context.Context, data *data_sources.AzureSubnetsModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var response []subnetApiModel
+	if err := c.doJSON(ctx, "GET", fmt.Sprintf("%s/api/azure/subnet", c.HostURL), nil, &response); err != nil {
+		diags.AddError("API Request Error", fmt.Sprintf("API request failed: %s", err))
+		return diags
+	}
+
+	sort.SliceStable(response, func(i, j int) bool {
+		return strings.ToLower(response[i].Id) < strings.ToLower(response[j].Id)
+	})
+
+	elements := []attr.Value{}
+	for _, subnet := range response {
+		free := subnet.Size - subnet.Used
+
+		if !matchesFold(data.VnetId, subnet.VnetId) {
+			continue
+		}
+
+		if !data.MinFreeAddresses.IsNull() && free < data.MinFreeAddresses.ValueInt64() {
+			continue
+		}
+
+		objVal, objDiags := data_sources.NewSubnetsValue(data_sources.NewSubnetsValueNull().AttributeTypes(ctx),
+			map[string]attr.Value{
+				"id":              types.StringValue(subnet.Id),
+				"name":            types.StringValue(subnet.Name),
+				"prefix":          types.StringValue(subnet.Prefix),
+				"size":            types.Int64Value(subnet.Size),
+				"used":            types.Int64Value(subnet.Used),
+				"free":            types.Int64Value(free),
+				"vnet_id":         types.StringValue(subnet.VnetId),
+				"vnet_name":       types.StringValue(subnet.VnetName),
+				"subscription_id": types.StringValue(subnet.SubscriptionId),
+				"resource_group":  types.StringValue(subnet.ResourceGroup),
+			},
+		)
+		diags.Append(objDiags...)
+		if diags.HasError() {
+			return diags
+		}
+		elements = append(elements, objVal)
+	}
+
+	subnetsList, listDiags := types.ListValue(data_sources.NewSubnetsValueNull().Type(ctx), elements)
+	diags.Append(listDiags...)
+	if diags.HasError() {
+		return diags
+	}
+
+	data.Subnets = subnetsList
+
+	return diags
+}
+
+// AzureEndpointsApiGet retrieves the Private Endpoints discovered by the
+// engine that match every filter set in the model.
+func (c *Client) AzureEndpointsApiGet(ctx context.Context, data *data_sources.AzureEndpointsModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var within netip.Prefix
+	if !data.WithinCidr.IsNull() {
+		prefix, err := cidr.Parse(data.WithinCidr.ValueString())
+		if err != nil {
+			diags.AddAttributeError(path.Root("within_cidr"), "Invalid CIDR", err.Error())
+			return diags
+		}
+		within = prefix
+	}
+
+	var response []endpointApiModel
+	if err := c.doJSON(ctx, "GET", fmt.Sprintf("%s/api/azure/endpoint", c.HostURL), nil, &response); err != nil {
+		diags.AddError("API Request Error", fmt.Sprintf("API request failed: %s", err))
+		return diags
+	}
+
+	sort.SliceStable(response, func(i, j int) bool {
+		return strings.ToLower(response[i].Id) < strings.ToLower(response[j].Id)
+	})
+
+	elements := []attr.Value{}
+	for _, endpoint := range response {
+		if !matchesFold(data.SubscriptionId, endpoint.SubscriptionId) ||
+			!matchesFold(data.VnetId, endpoint.VnetId) ||
+			!matchesFold(data.SubnetId, endpoint.SubnetId) {
+			continue
+		}
+
+		if within.IsValid() {
+			addr, err := netip.ParseAddr(endpoint.PrivateIp)
+			if err != nil || !within.Contains(addr) {
+				continue
+			}
+		}
+
+		objVal, objDiags := data_sources.NewEndpointsValue(data_sources.NewEndpointsValueNull().AttributeTypes(ctx),
+			map[string]attr.Value{
+				"id":              types.StringValue(endpoint.Id),
+				"name":            types.StringValue(endpoint.Name),
+				"private_ip":      types.StringValue(endpoint.PrivateIp),
+				"subscription_id": types.StringValue(endpoint.SubscriptionId),
+				"resource_group":  types.StringValue(endpoint.ResourceGroup),
+				"vnet_id":         types.StringValue(endpoint.VnetId),
+				"vnet_name":       types.StringValue(endpoint.VnetName),
+				"subnet_id":       types.StringValue(endpoint.SubnetId),
+				"subnet_name":     types.StringValue(endpoint.SubnetName),
+			},
+		)
+		diags.Append(objDiags...)
+		if diags.HasError() {
+			return diags
+		}
+		elements = append(elements, objVal)
+	}
+
+	endpointsList, listDiags := types.ListValue(data_sources.NewEndpointsValueNull().Type(ctx), elements)
+	diags.Append(listDiags...)
+	if diags.HasError() {
+		return diags
+	}
+
+	data.Endpoints = endpointsList
+
+	return diags
+}
+
+// AzureSubscriptionsApiGet retrieves the subscriptions visible to the engine
+// that match every filter set in the model, and flags the ones excluded from
+// Virtual Network discovery. Only admins may read the exclusions, so for
+// other callers excluded is left null.
+func (c *Client) AzureSubscriptionsApiGet(ctx context.Context, data *data_sources.AzureSubscriptionsModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var nameRegex *regexp.Regexp
+	if !data.NameRegex.IsNull() {
+		re, err := regexp.Compile(data.NameRegex.ValueString())
+		if err != nil {
+			diags.AddAttributeError(path.Root("name_regex"), "Invalid Regular Expression", err.Error())
+			return diags
+		}
+		nameRegex = re
+	}
+
+	var response []subscriptionApiModel
+	if err := c.doJSON(ctx, "GET", fmt.Sprintf("%s/api/azure/subscription", c.HostURL), nil, &response); err != nil {
+		diags.AddError("API Request Error", fmt.Sprintf("API request failed: %s", err))
+		return diags
+	}
+
+	exclusionsKnown := true
+	exclusions, err := c.exclusionsGet(ctx)
+	if err != nil {
+		var apiErr *APIError
+		if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusForbidden {
+			diags.AddError("API Request Error", fmt.Sprintf("API request failed: %s", err))
+			return diags
+		}
+
+		exclusionsKnown = false
+		diags.AddWarning(
+			"Subscription Exclusions Unavailable",
+			"Only Azure IPAM admins can read the subscriptions excluded from Virtual Network discovery, so excluded is null for every subscription.",
+		)
+	}
+
+	elements := []attr.Value{}
+	for _, subscription := range response {
+		if !matchesFold(data.Name, subscription.Name) ||
+			!matchesFold(data.SubscriptionId, subscription.SubscriptionId) {
+			continue
+		}
+
+		if nameRegex != nil && !nameRegex.MatchString(subscription.Name) {
+			continue
+		}
+
+		excluded := types.BoolNull()
+		if exclusionsKnown {
+			excluded = types.BoolValue(findExclusion(exclusions, subscription.SubscriptionId) >= 0)
+		}
+
+		objVal, objDiags := data_sources.NewSubscriptionsValue(data_sources.NewSubscriptionsValueNull().AttributeTypes(ctx),
+			map[string]attr.Value{
+				"subscription_id":       types.StringValue(subscription.SubscriptionId),
+				"name":                  types.StringValue(subscription.Name),
+				"tenant_id":             types.StringValue(subscription.TenantId),
+				"type":                  stringValueOrNull(subscription.Type),
+				"management_group_id":   stringValueOrNull(subscription.MgId),
+				"management_group_name": stringValueOrNull(subscription.MgName),
+				"excluded":              excluded,
+			},
+		)
+		diags.Append(objDiags...)
+		if diags.HasError() {
+			return diags
+		}
+		elements = append(elements, objVal)
+	}
+
+	subscriptionsList, listDiags := types.ListValue(data_sources.NewSubscriptionsValueNull().Type(ctx), elements)
+	diags.Append(listDiags...)
+	if diags.HasError() {
+		return diags
+	}
+
+	data.Subscriptions = subscriptionsList
+
+	return diags
+}
+
+// azureVnetsGet retrieves every Virtual Network discovered by the engine.
+func (c *Client) azureVnetsGet(ctx context.Context) ([]vnetApiModel, error) {
+	var response []vnetApiModel
+
+	if err := c.doJSON(ctx, "GET", fmt.Sprintf("%s/api/azure/vnet", c.HostURL), nil, &response); err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// prefixesContain reports whether any of prefixes contains the CIDR s.
+// Prefixes the engine reports that cannot be parsed are ignored.
+func prefixesContain(prefixes []string, s string) (bool, error) {
+	inner, err := cidr.Parse(s)
+	if err != nil {
+		return false, err
+	}
+
+	for _, v := range prefixes {
+		if prefix, err := cidr.Parse(v); err == nil && cidr.Contains(prefix, inner) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// matches reports whether value equals filter, or filter is not set.
+func matches(filter types.String, value string) bool {
+	return filter.IsNull() || filter.ValueString() == value
+}
+
+// matchesFold is matches for values Azure compares case-insensitively.
+func matchesFold(filter types.String, value string) bool {
+	return filter.IsNull() || strings.EqualFold(filter.ValueString(), value)
+}
@@ -0,0 +1,108 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"net/netip"
+	"terraform-provider-azureipam/internal/cidr"
+	"terraform-provider-azureipam/internal/gen/data_sources"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// BlockUtilizationApiGet retrieves a Block and computes how much of it is
+// used, reserved and free.
+func (c *Client) BlockUtilizationApiGet(ctx context.Context, data *data_sources.BlockUtilizationModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	block, err := c.blockGet(ctx, data.Space.ValueString(), data.Block.ValueString())
+	if err != nil {
+		diags.AddError("API Request Error", fmt.Sprintf("API request failed: %s", err))
+		return diags
+	}
+
+	prefix, err := cidr.Parse(block.Cidr)
+	if err != nil {
+		diags.AddError("Invalid Block CIDR", err.Error())
+		return diags
+	}
+
+	used, reserved := blockAllocations(block)
+
+	total := cidr.Size(prefix)
+	notUsed := cidr.Count(cidr.Free(prefix, used))
+	free := cidr.Free(prefix, append(used, reserved...))
+	freeCount := cidr.Count(free)
+
+	usedCount := new(big.Int).Sub(total, notUsed)
+	reservedCount := new(big.Int).Sub(notUsed, freeCount)
+
+	utilization := new(big.Float).SetInt(new(big.Int).Sub(total, freeCount))
+	utilization.Quo(utilization, new(big.Float).SetInt(total))
+	utilization.Mul(utilization, big.NewFloat(100))
+
+	freePrefixes := make([]string, len(free))
+	for i, p := range free {
+		freePrefixes[i] = p.String()
+	}
+
+	data.Cidr = types.StringValue(block.Cidr)
+	data.TotalAddresses = types.NumberValue(new(big.Float).SetInt(total))
+	data.UsedAddresses = types.NumberValue(new(big.Float).SetInt(usedCount))
+	data.ReservedAddresses = types.NumberValue(new(big.Float).SetInt(reservedCount))
+	data.FreeAddresses = types.NumberValue(new(big.Float).SetInt(freeCount))
+	data.Utilization = types.NumberValue(utilization)
+
+	freeList, listDiags := types.ListValueFrom(ctx, types.StringType, freePrefixes)
+	diags.Append(listDiags...)
+	data.FreePrefixes = freeList
+
+	return diags
+}
+
+// blockFree returns the prefixes of a Block that are neither used nor
+// reserved.
+func blockFree(block blockApiModel) ([]netip.Prefix, error) {
+	prefix, err := cidr.Parse(block.Cidr)
+	if err != nil {
+		return nil, err
+	}
+
+	used, reserved := blockAllocations(block)
+
+	return cidr.Free(prefix, append(used, reserved...)), nil
+}
+
+// blockAllocations returns the prefixes of a Block used by associated Virtual
+// Networks and External Networks, and those held by unsettled reservations.
+// CIDRs the engine reports that cannot be parsed are ignored.
+func blockAllocations(block blockApiModel) ([]netip.Prefix, []netip.Prefix) {
+	var used, reserved []netip.Prefix
+
+	for _, vnet := range block.Vnets {
+		for _, v := range vnet.Prefixes {
+			if prefix, err := cidr.Parse(v); err == nil {
+				used = append(used, prefix)
+			}
+		}
+	}
+
+	for _, external := range block.Externals {
+		if prefix, err := cidr.Parse(external.Cidr); err == nil {
+			used = append(used, prefix)
+		}
+	}
+
+	for _, resv := range block.Resv {
+		if resv.SettledOn != 0 {
+			continue
+		}
+		if prefix, err := cidr.Parse(resv.CIDR); err == nil {
+			reserved = append(reserved, prefix)
+		}
+	}
+
+	return used, reserved
+}
@@ -0,0 +1,239 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"sort"
+	"strings"
+	"terraform-provider-azureipam/internal/cidr"
+	"terraform-provider-azureipam/internal/gen/resources"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// capacityBlock is a Block a planned Reservation may be allocated from, with
+// the prefixes free for it.
+type capacityBlock struct {
+	name        string
+	cidr        string
+	allocations string
+	free        []netip.Prefix
+}
+
+// plannedBlock holds the prefixes of the Reservations planned in a Block. They
+// only count while the Block has the allocations it had when they were
+// planned: once Reservations are created, as during the apply, the Block
+// accounts for them itself.
+type plannedBlock struct {
+	allocations string
+	prefixes    []netip.Prefix
+}
+
+// ReservationCapacityCheck verifies at plan time that a Block of the
+// Reservation exists and has a free prefix for it. Reservations checked
+// earlier by the same client are accounted for, so that Reservations of the
+// same plan competing for the last free prefixes of a Block are reported.
+// Reservations being replaced are counted as free. Nothing is checked while
+// the size of the Reservation is unknown.
+func (c *Client) ReservationCapacityCheck(ctx context.Context, data *resources.ReservationModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// An exact CIDR must be free itself, otherwise any free prefix of the
+	// requested size will do.
+	var exact netip.Prefix
+	var bits int
+	switch {
+	case !data.Cidr.IsNull() && !data.Cidr.IsUnknown():
+		prefix, err := cidr.Parse(data.Cidr.ValueString())
+		if err != nil {
+			return diags
+		}
+		exact, bits = prefix, prefix.Bits()
+	case !data.PreferredCidr.IsNull() && !data.PreferredCidr.IsUnknown():
+		prefix, err := cidr.Parse(data.PreferredCidr.ValueString())
+		if err != nil {
+			return diags
+		}
+		bits = prefix.Bits()
+	case !data.Size.IsNull() && !data.Size.IsUnknown():
+		bits = int(data.Size.ValueInt64())
+	default:
+		return diags
+	}
+
+	names, diags := ReservationBlockNames(ctx, data)
+	if diags.HasError() {
+		return diags
+	}
+
+	within, exclude, constraintDiags := reservationConstraints(ctx, data)
+	diags.Append(constraintDiags...)
+	if diags.HasError() {
+		return diags
+	}
+
+	space := data.Space.ValueString()
+
+	var blocks []capacityBlock
+	var missing []string
+	for _, name := range names {
+		response, err := c.blockGet(ctx, space, name)
+		if err != nil {
+			if isNotFound(err) {
+				missing = append(missing, name)
+				continue
+			}
+			diags.AddError("API Request Error", fmt.Sprintf("API request failed: %s", err))
+			return diags
+		}
+
+		prefix, err := cidr.Parse(response.Cidr)
+		if err != nil {
+			diags.AddError("Invalid Block CIDR", err.Error())
+			return diags
+		}
+
+		used, reserved := blockAllocations(response)
+		allocations := prefixList(append(used, reserved...))
+		reserved = cidr.Exclude(reserved, c.replacedPrefixes(space, name))
+
+		free := cidr.Free(prefix, append(used, reserved...))
+		if within.IsValid() {
+			free = cidr.Within(free, within)
+		}
+		blocks = append(blocks, capacityBlock{name: name, cidr: response.Cidr, allocations: allocations, free: cidr.Exclude(free, exclude)})
+	}
+
+	if len(blocks) == 0 {
+		diags.AddError(
+			"Block Not Found",
+			fmt.Sprintf("Space %s has no Block named %s.", space, strings.Join(missing, " or ")),
+		)
+		return diags
+	}
+
+	pick := func(free []netip.Prefix) (netip.Prefix, bool) {
+		if exact.IsValid() {
+			for _, prefix := range free {
+				if cidr.Contains(prefix, exact) {
+					return exact, true
+				}
+			}
+			return netip.Prefix{}, false
+		}
+		return cidr.Pick(free, bits, data.ReverseSearch.ValueBool(), data.SmallestCidr.ValueBool())
+	}
+
+	c.plannedMu.Lock()
+	defer c.plannedMu.Unlock()
+
+	if c.planned == nil {
+		c.planned = map[string]*plannedBlock{}
+	}
+
+	var competing []string
+	for _, block := range blocks {
+		if _, ok := pick(block.free); !ok {
+			continue
+		}
+
+		key := space + "/" + block.name
+		planned := c.planned[key]
+		if planned == nil || planned.allocations != block.allocations {
+			planned = &plannedBlock{allocations: block.allocations}
+			c.planned[key] = planned
+		}
+
+		if prefix, ok := pick(cidr.Exclude(block.free, planned.prefixes)); ok {
+			planned.prefixes = append(planned.prefixes, prefix)
+			return diags
+		}
+
+		competing = append(competing, fmt.Sprintf("%s (%d other Reservations planned)", block.name, len(planned.prefixes)))
+	}
+
+	if len(competing) > 0 {
+		diags.AddWarning(
+			"Reservations Compete for Block",
+			fmt.Sprintf("The /%d Reservation fits in %s, but not together with the other Reservations planned there. "+
+				"Some of them are likely to fail to apply.", bits, strings.Join(competing, ", ")),
+		)
+		return diags
+	}
+
+	if exact.IsValid() {
+		diags.AddError(
+			"CIDR Not Available",
+			fmt.Sprintf("%s is not free in %s of Space %s.", exact, blockList(names), space),
+		)
+		return diags
+	}
+
+	diags.AddError(
+		"Insufficient Block Capacity",
+		fmt.Sprintf("%s of Space %s has no free /%d prefix for the Reservation.", blockList(names), space, bits),
+	)
+
+	return diags
+}
+
+// blockList names the Blocks in a diagnostic.
+func blockList(names []string) string {
+	if len(names) == 1 {
+		return "Block " + names[0]
+	}
+
+	return "Blocks " + strings.Join(names, ", ")
+}
+
+// ReservationReplaced records that the Reservation in a state is about to be
+// replaced. It is never adopted by the Reservation replacing it and, while it
+// is unsettled, its prefix is counted as free by its capacity check.
+func (c *Client) ReservationReplaced(data *resources.ReservationModel) {
+	c.reservationHold(data.Id.ValueString())
+
+	if data.Status.ValueString() != reservationStatusWait {
+		return
+	}
+
+	prefix, err := cidr.Parse(data.Cidr.ValueString())
+	if err != nil {
+		return
+	}
+
+	block := data.AllocatedBlock.ValueString()
+	if data.AllocatedBlock.IsNull() {
+		block = data.Block.ValueString()
+	}
+
+	c.plannedMu.Lock()
+	defer c.plannedMu.Unlock()
+
+	if c.replaced == nil {
+		c.replaced = map[string][]netip.Prefix{}
+	}
+
+	key := data.Space.ValueString() + "/" + block
+	c.replaced[key] = append(c.replaced[key], prefix)
+}
+
+// replacedPrefixes returns the prefixes of the Reservations of a Block which
+// are being replaced.
+func (c *Client) replacedPrefixes(space, block string) []netip.Prefix {
+	c.plannedMu.Lock()
+	defer c.plannedMu.Unlock()
+
+	return c.replaced[space+"/"+block]
+}
+
+// prefixList identifies a set of prefixes independently of their order.
+func prefixList(prefixes []netip.Prefix) string {
+	names := make([]string, 0, len(prefixes))
+	for _, prefix := range prefixes {
+		names = append(names, prefix.String())
+	}
+	sort.Strings(names)
+
+	return strings.Join(names, ",")
+}
@@ -1,8 +1,15 @@
 package client
 
 import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
 	"io"
 	"net/http"
+	"net/netip"
+	"sync"
 	"time"
 )
 
@@ -11,6 +18,34 @@ type Client struct {
 	HostURL    string
 	HTTPClient *http.Client
 	Token      string
+
+	// planned holds the Reservations planned so far and replaced holds the
+	// prefixes of the Reservations being replaced, by Space and Block, for
+	// the capacity check.
+	plannedMu sync.Mutex
+	planned   map[string]*plannedBlock
+	replaced  map[string][]netip.Prefix
+
+	// held holds the IDs of the Reservations found in the state, which are
+	// never adopted by a Reservation being created.
+	heldMu sync.Mutex
+	held   map[string]bool
+}
+
+// APIError is returned by DoRequest when the engine answers with a non-2xx status.
+type APIError struct {
+	StatusCode int
+	Body       string
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("unexpected status code %d: %s", e.StatusCode, e.Body)
+}
+
+// isNotFound reports whether err is the engine answering 404 Not Found.
+func isNotFound(err error) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound
 }
 
 // NewClient -
@@ -28,6 +63,8 @@ func NewClient(host, token *string) (*Client, error) {
 	return &c, nil
 }
 
+// DoRequest sends req with the authorization token. A response with a non-2xx
+// status is returned as an *APIError rather than as a body to unmarshal.
 func (c *Client) DoRequest(req *http.Request, authToken *string) ([]byte, error) {
 	token := c.Token
 
@@ -48,5 +85,45 @@ func (c *Client) DoRequest(req *http.Request, authToken *string) ([]byte, error)
 		return nil, err
 	}
 
+	if res.StatusCode < http.StatusOK || res.StatusCode >= http.StatusMultipleChoices {
+		return nil, &APIError{StatusCode: res.StatusCode, Body: string(body)}
+	}
+
 	return body, err
 }
+
+// doJSON sends payload (when not nil) as the JSON request body and unmarshals
+// the response into out (when not nil).
+func (c *Client) doJSON(ctx context.Context, method, url string, payload, out interface{}) error {
+	var body io.Reader
+	if payload != nil {
+		data, err := json.Marshal(payload)
+		if err != nil {
+			return fmt.Errorf("failed to marshal request body: %w", err)
+		}
+		body = bytes.NewBuffer(data)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, url, body)
+	if err != nil {
+		return fmt.Errorf("could not create HTTP request: %w", err)
+	}
+	if payload != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	respBody, err := c.DoRequest(req, &c.Token)
+	if err != nil {
+		return err
+	}
+
+	if out == nil {
+		return nil
+	}
+
+	if err := json.Unmarshal(respBody, out); err != nil {
+		return fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	return nil
+}
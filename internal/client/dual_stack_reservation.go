@@ -0,0 +1,172 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"terraform-provider-azureipam/internal/gen/resources"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// DualStackReservationApiPost reserves an IPv4 and an IPv6 range. When the
+// IPv6 Reservation fails the IPv4 one is deleted again, so that no orphaned
+// range is left behind.
+func (c *Client) DualStackReservationApiPost(ctx context.Context, data *resources.DualStackReservationModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	space := data.Space.ValueString()
+
+	// Checking both Blocks up front avoids a rollback for the most common
+	// misconfiguration.
+	diags.Append(c.reservationBlockVersionCheck(ctx, space, data.Ipv4Block.ValueString(), 4, path.Root("ipv4_block"))...)
+	diags.Append(c.reservationBlockVersionCheck(ctx, space, data.Ipv6Block.ValueString(), 6, path.Root("ipv6_block"))...)
+	if diags.HasError() {
+		return diags
+	}
+
+	ipv4, postDiags := c.reservationPost(ctx, reservationApiModel{
+		Space: space,
+		Block: data.Ipv4Block.ValueString(),
+		Size:  data.Ipv4Size.ValueInt64(),
+		Desc:  data.Desc.ValueString(),
+	})
+	diags.Append(postDiags...)
+	if diags.HasError() {
+		return diags
+	}
+
+	ipv6, postDiags := c.reservationPost(ctx, reservationApiModel{
+		Space: space,
+		Block: data.Ipv6Block.ValueString(),
+		Size:  data.Ipv6Size.ValueInt64(),
+		Desc:  data.Desc.ValueString(),
+	})
+	diags.Append(postDiags...)
+	if diags.HasError() {
+		if deleteDiags := c.reservationDelete(ctx, space, data.Ipv4Block.ValueString(), ipv4.Id); deleteDiags.HasError() {
+			diags.AddError(
+				"Failed to roll back IPv4 Reservation",
+				fmt.Sprintf("The IPv6 Reservation failed and the IPv4 Reservation %s (%s) in Block %s could not be deleted. "+
+					"It must be deleted manually.", ipv4.Id, ipv4.CIDR, data.Ipv4Block.ValueString()),
+			)
+			diags.Append(deleteDiags...)
+		}
+		return diags
+	}
+
+	mapDualStackResponseToModel(ipv4, ipv6, data)
+
+	return diags
+}
+
+// DualStackReservationApiGet refreshes both Reservations. It reports false
+// when either of them no longer exists or was cancelled, as the pair can then
+// only be recreated.
+func (c *Client) DualStackReservationApiGet(ctx context.Context, data *resources.DualStackReservationModel) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	space := data.Space.ValueString()
+
+	ipv4, ipv4Found, err := c.reservationGet(ctx, space, data.Ipv4Block.ValueString(), data.Ipv4Id.ValueString())
+	if err != nil {
+		diags.AddError("API Request Error", fmt.Sprintf("API request failed: %s", err))
+		return false, diags
+	}
+
+	ipv6, ipv6Found, err := c.reservationGet(ctx, space, data.Ipv6Block.ValueString(), data.Ipv6Id.ValueString())
+	if err != nil {
+		diags.AddError("API Request Error", fmt.Sprintf("API request failed: %s", err))
+		return false, diags
+	}
+
+	// The remaining Reservation is not deleted here, a refresh must not
+	// change the engine, so it is named for it to be cleaned up.
+	if ipv4Found != ipv6Found {
+		remaining, block := ipv4, data.Ipv4Block.ValueString()
+		if ipv6Found {
+			remaining, block = ipv6, data.Ipv6Block.ValueString()
+		}
+		diags.AddWarning(
+			"Dual-Stack Reservation Incomplete",
+			fmt.Sprintf("One of the Reservations no longer exists, so the pair is removed from the state and will be recreated. "+
+				"Reservation %s (%s) in Block %s is left behind and must be deleted manually.", remaining.Id, remaining.CIDR, block),
+		)
+	}
+
+	if !ipv4Found || !ipv6Found {
+		return false, diags
+	}
+
+	mapDualStackResponseToModel(ipv4, ipv6, data)
+
+	return true, diags
+}
+
+// DualStackReservationApiDelete deletes both Reservations. The IPv6
+// Reservation is deleted even if deleting the IPv4 one fails.
+func (c *Client) DualStackReservationApiDelete(ctx context.Context, data *resources.DualStackReservationModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	space := data.Space.ValueString()
+
+	diags.Append(c.reservationDelete(ctx, space, data.Ipv4Block.ValueString(), data.Ipv4Id.ValueString())...)
+	diags.Append(c.reservationDelete(ctx, space, data.Ipv6Block.ValueString(), data.Ipv6Id.ValueString())...)
+
+	return diags
+}
+
+// reservationPost creates a Reservation in the Block of payload.
+func (c *Client) reservationPost(ctx context.Context, payload reservationApiModel) (reservationApiModel, diag.Diagnostics) {
+	url := fmt.Sprintf("%s/api/spaces/%s/blocks/%s/reservations", c.HostURL, strings.Trim(payload.Space, "\""), strings.Trim(payload.Block, "\""))
+
+	return c.reservationExecuteRequest(ctx, "POST", url, payload)
+}
+
+// reservationGet reads a Reservation. It reports false when the Reservation no
+// longer exists or was cancelled through the engine.
+func (c *Client) reservationGet(ctx context.Context, space, block, id string) (reservationApiModel, bool, error) {
+	var response reservationApiModel
+	if err := c.doJSON(ctx, "GET", c.reservationUrl(space, block, id), nil, &response); err != nil {
+		if isNotFound(err) {
+			return reservationApiModel{}, false, nil
+		}
+		return reservationApiModel{}, false, err
+	}
+
+	if response.Status == reservationStatusCancelled {
+		return reservationApiModel{}, false, nil
+	}
+
+	return response, true, nil
+}
+
+// reservationDelete deletes a Reservation.
+func (c *Client) reservationDelete(ctx context.Context, space, block, id string) diag.Diagnostics {
+	payload := reservationApiModel{
+		Space: space,
+		Block: block,
+		Id:    id,
+	}
+
+	_, diags := c.reservationExecuteRequest(ctx, "DELETE", c.reservationUrl(space, block, id), payload)
+
+	return diags
+}
+
+// reservationUrl returns the URL of a single Reservation.
+func (c *Client) reservationUrl(space, block, id string) string {
+	return fmt.Sprintf("%s/api/spaces/%s/blocks/%s/reservations/%s",
+		c.HostURL, strings.Trim(space, "\""), strings.Trim(block, "\""), strings.Trim(id, "\""))
+}
+
+func mapDualStackResponseToModel(ipv4, ipv6 reservationApiModel, data *resources.DualStackReservationModel) {
+	data.Ipv4Id = types.StringValue(ipv4.Id)
+	data.Ipv4Cidr = types.StringValue(ipv4.CIDR)
+	data.Ipv4Status = types.StringValue(ipv4.Status)
+	data.Ipv6Id = types.StringValue(ipv6.Id)
+	data.Ipv6Cidr = types.StringValue(ipv6.CIDR)
+	data.Ipv6Status = types.StringValue(ipv6.Status)
+}
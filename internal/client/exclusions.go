@@ -0,0 +1,148 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"terraform-provider-azureipam/internal/gen/resources"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// ExclusionsApiPut replaces the subscriptions excluded from Virtual Network
+// discovery and refreshes the model from the engine.
+func (c *Client) ExclusionsApiPut(ctx context.Context, data *resources.ExclusionsModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	payload := []string{}
+	diags.Append(data.SubscriptionIds.ElementsAs(ctx, &payload, false)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if err := c.doJSON(ctx, "PUT", c.exclusionsUrl(), payload, nil); err != nil {
+		diags.AddError("API Request Error", fmt.Sprintf("API request failed: %s", err))
+		return diags
+	}
+
+	return c.ExclusionsApiGet(ctx, data)
+}
+
+// ExclusionsApiGet retrieves the excluded subscriptions. Subscription IDs are
+// compared case-insensitively, and IDs already in the model keep their
+// spelling so that a difference in case is not reported as drift.
+func (c *Client) ExclusionsApiGet(ctx context.Context, data *resources.ExclusionsModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	response, err := c.exclusionsGet(ctx)
+	if err != nil {
+		diags.AddError("API Request Error", fmt.Sprintf("API request failed: %s", err))
+		return diags
+	}
+
+	var current []string
+	if !data.SubscriptionIds.IsNull() && !data.SubscriptionIds.IsUnknown() {
+		diags.Append(data.SubscriptionIds.ElementsAs(ctx, &current, false)...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	ids := make([]string, len(response))
+	for i, id := range response {
+		ids[i] = id
+		if j := findExclusion(current, id); j >= 0 {
+			ids[i] = current[j]
+		}
+	}
+
+	subscriptionIds, setDiags := types.SetValueFrom(ctx, types.StringType, ids)
+	diags.Append(setDiags...)
+	data.SubscriptionIds = subscriptionIds
+
+	return diags
+}
+
+// ExclusionsApiDelete removes every subscription exclusion.
+func (c *Client) ExclusionsApiDelete(ctx context.Context) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if err := c.doJSON(ctx, "PUT", c.exclusionsUrl(), []string{}, nil); err != nil {
+		diags.AddError("API Request Error", fmt.Sprintf("API request failed: %s", err))
+	}
+
+	return diags
+}
+
+// ExclusionApiPost excludes a single subscription from Virtual Network
+// discovery.
+func (c *Client) ExclusionApiPost(ctx context.Context, data *resources.ExclusionModel) diag.Diagnostics {
+	id := data.SubscriptionId.ValueString()
+
+	return modifyList(ctx, c, c.exclusionsUrl(), "Exclusions", func(exclusions []string) ([]string, error) {
+		if findExclusion(exclusions, id) >= 0 {
+			return nil, fmt.Errorf("subscription %s is already excluded, import it to manage it with Terraform", id)
+		}
+
+		return append(exclusions, id), nil
+	}, func(exclusions []string) bool {
+		return findExclusion(exclusions, id) >= 0
+	})
+}
+
+// ExclusionApiGet reports whether the subscription is still excluded.
+func (c *Client) ExclusionApiGet(ctx context.Context, data *resources.ExclusionModel) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	exclusions, err := c.exclusionsGet(ctx)
+	if err != nil {
+		diags.AddError("API Request Error", fmt.Sprintf("API request failed: %s", err))
+		return false, diags
+	}
+
+	return findExclusion(exclusions, data.SubscriptionId.ValueString()) >= 0, diags
+}
+
+// ExclusionApiDelete stops excluding a single subscription. Removing an
+// exclusion that no longer exists is not an error.
+func (c *Client) ExclusionApiDelete(ctx context.Context, data *resources.ExclusionModel) diag.Diagnostics {
+	id := data.SubscriptionId.ValueString()
+
+	return modifyList(ctx, c, c.exclusionsUrl(), "Exclusions", func(exclusions []string) ([]string, error) {
+		i := findExclusion(exclusions, id)
+		if i < 0 {
+			return exclusions, nil
+		}
+
+		return append(exclusions[:i], exclusions[i+1:]...), nil
+	}, func(exclusions []string) bool {
+		return findExclusion(exclusions, id) < 0
+	})
+}
+
+// exclusionsGet retrieves the IDs of the excluded subscriptions.
+func (c *Client) exclusionsGet(ctx context.Context) ([]string, error) {
+	var response []string
+
+	if err := c.doJSON(ctx, "GET", c.exclusionsUrl(), nil, &response); err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+func (c *Client) exclusionsUrl() string {
+	return fmt.Sprintf("%s/api/admin/exclusions", c.HostURL)
+}
+
+// findExclusion returns the index of the subscription ID, or -1.
+func findExclusion(exclusions []string, id string) int {
+	for i, exclusion := range exclusions {
+		if strings.EqualFold(exclusion, id) {
+			return i
+		}
+	}
+
+	return -1
+}
@@ -0,0 +1,90 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// modifyListMaxAttempts bounds how often a read-modify-write of a list is
+// retried when another writer changes the list concurrently.
+const modifyListMaxAttempts = 5
+
+// modifyList replaces the list at url with the result of modify, for the
+// engine's list endpoints that only support replacing the whole list. The
+// engine has no conditional writes, so another writer may replace the list
+// between the read and the write. The list is therefore read again right
+// before it is written, and the write is only made if it did not change. It
+// is read once more afterwards, and the whole read-modify-write is retried
+// until applied reports the change as present and every entry read before
+// the write that modify kept is still there, so that neither this change nor
+// another writer's is lost. name is the capitalized name of the list used in
+// diagnostics.
+func modifyList[T comparable](ctx context.Context, c *Client, url, name string, modify func([]T) ([]T, error), applied func([]T) bool) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for attempt := 1; attempt <= modifyListMaxAttempts; attempt++ {
+		var current []T
+		if err := c.doJSON(ctx, "GET", url, nil, &current); err != nil {
+			diags.AddError("API Request Error", fmt.Sprintf("API request failed: %s", err))
+			return diags
+		}
+
+		updated, err := modify(slices.Clone(current))
+		if err != nil {
+			diags.AddError(fmt.Sprintf("Failed to Update %s", name), err.Error())
+			return diags
+		}
+
+		var latest []T
+		if err := c.doJSON(ctx, "GET", url, nil, &latest); err != nil {
+			diags.AddError("API Request Error", fmt.Sprintf("API request failed: %s", err))
+			return diags
+		}
+
+		if slices.Equal(current, latest) {
+			if err := c.doJSON(ctx, "PUT", url, updated, nil); err != nil {
+				diags.AddError("API Request Error", fmt.Sprintf("API request failed: %s", err))
+				return diags
+			}
+
+			var written []T
+			if err := c.doJSON(ctx, "GET", url, nil, &written); err != nil {
+				diags.AddError("API Request Error", fmt.Sprintf("API request failed: %s", err))
+				return diags
+			}
+
+			if applied(written) && keptEntries(current, updated, written) {
+				return diags
+			}
+		}
+
+		tflog.Debug(ctx, "List changed concurrently, retrying", map[string]interface{}{
+			"list":    name,
+			"attempt": attempt,
+		})
+	}
+
+	diags.AddError(
+		fmt.Sprintf("%s Conflict", name),
+		fmt.Sprintf("The list of %s was changed concurrently during %d attempts to update it. Please try again.", strings.ToLower(name), modifyListMaxAttempts),
+	)
+
+	return diags
+}
+
+// keptEntries reports whether every entry of before that is also in updated,
+// that is every entry the modification left alone, is in written.
+func keptEntries[T comparable](before, updated, written []T) bool {
+	for _, entry := range before {
+		if slices.Contains(updated, entry) && !slices.Contains(written, entry) {
+			return false
+		}
+	}
+
+	return true
+}
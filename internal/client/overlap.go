@@ -0,0 +1,262 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/netip"
+	"strings"
+	"terraform-provider-azureipam/internal/cidr"
+	"terraform-provider-azureipam/internal/gen/data_sources"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	overlapKindBlock       = "block"
+	overlapKindReservation = "reservation"
+	overlapKindVnet        = "vnet"
+	overlapKindExternal    = "external"
+)
+
+// overlapRequestApiModel is the request body of the engine's vnetOverlap tool.
+type overlapRequestApiModel struct {
+	Space string   `json:"space"`
+	Cidrs []string `json:"cidrs"`
+}
+
+// overlapApiModel describes a network overlapping one of the checked CIDRs.
+type overlapApiModel struct {
+	Cidr            string `json:"cidr"`
+	OverlappingCidr string `json:"overlap"`
+	Kind            string `json:"type"`
+	Name            string `json:"name"`
+	Block           string `json:"block"`
+}
+
+// overlapCheck is a CIDR to check, along with the Virtual Network it was
+// taken from, if any, so that a Virtual Network is not reported as
+// overlapping with itself.
+type overlapCheck struct {
+	cidr   string
+	prefix netip.Prefix
+	vnetId string
+}
+
+// OverlapCheckApiGet reports the networks of a Space overlapping the requested
+// CIDRs and Virtual Networks. The engine's vnetOverlap tool is used when it is
+// available, otherwise the overlaps are computed from the expanded Space. Any
+// other failure of the tool, such as an authorization error, is reported.
+func (c *Client) OverlapCheckApiGet(ctx context.Context, data *data_sources.OverlapCheckModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var cidrs, vnetIds []string
+	diags.Append(data.Cidrs.ElementsAs(ctx, &cidrs, false)...)
+	diags.Append(data.VnetIds.ElementsAs(ctx, &vnetIds, false)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	checks := make([]overlapCheck, 0, len(cidrs))
+	for _, v := range cidrs {
+		prefix, err := cidr.Parse(v)
+		if err != nil {
+			diags.AddError("Invalid CIDR", err.Error())
+			return diags
+		}
+		checks = append(checks, overlapCheck{cidr: v, prefix: prefix})
+	}
+
+	if len(vnetIds) > 0 {
+		vnets, err := c.azureVnetsGet(ctx)
+		if err != nil {
+			diags.AddError("API Request Error", fmt.Sprintf("API request failed: %s", err))
+			return diags
+		}
+
+		for _, id := range vnetIds {
+			vnet, ok := findVnet(vnets, id)
+			if !ok {
+				diags.AddError("Virtual Network Not Found", fmt.Sprintf("Virtual Network %s has not been discovered by the Azure IPAM engine.", id))
+				return diags
+			}
+
+			for _, v := range vnet.Prefixes {
+				prefix, err := cidr.Parse(v)
+				if err != nil {
+					diags.AddError("Invalid CIDR", err.Error())
+					return diags
+				}
+				checks = append(checks, overlapCheck{cidr: v, prefix: prefix, vnetId: vnet.Id})
+			}
+		}
+	}
+
+	space := strings.Trim(data.Space.ValueString(), "\"")
+
+	overlaps, err := c.overlapEngineCheck(ctx, space, checks)
+	if err != nil {
+		if !overlapEngineUnavailable(err) {
+			diags.AddError("API Request Error", fmt.Sprintf("API request failed: %s", err))
+			return diags
+		}
+
+		tflog.Debug(ctx, "Engine overlap check unavailable, computing overlaps locally", map[string]interface{}{
+			"error": err.Error(),
+		})
+
+		overlaps, err = c.overlapLocalCheck(ctx, space, checks)
+		if err != nil {
+			diags.AddError("API Request Error", fmt.Sprintf("API request failed: %s", err))
+			return diags
+		}
+	}
+
+	elements := make([]attr.Value, len(overlaps))
+	for i, overlap := range overlaps {
+		objVal, objDiags := data_sources.NewOverlapsValue(data_sources.NewOverlapsValueNull().AttributeTypes(ctx),
+			map[string]attr.Value{
+				"cidr":             types.StringValue(overlap.Cidr),
+				"overlapping_cidr": types.StringValue(overlap.OverlappingCidr),
+				"kind":             types.StringValue(overlap.Kind),
+				"name":             types.StringValue(overlap.Name),
+				"block":            types.StringValue(overlap.Block),
+			},
+		)
+		diags.Append(objDiags...)
+		if diags.HasError() {
+			return diags
+		}
+		elements[i] = objVal
+	}
+
+	overlapsList, listDiags := types.ListValue(data_sources.NewOverlapsValueNull().Type(ctx), elements)
+	diags.Append(listDiags...)
+	if diags.HasError() {
+		return diags
+	}
+
+	data.Overlaps = overlapsList
+	data.HasOverlaps = types.BoolValue(len(overlaps) > 0)
+
+	return diags
+}
+
+// overlapEngineCheck asks the engine's vnetOverlap tool for the overlaps.
+func (c *Client) overlapEngineCheck(ctx context.Context, space string, checks []overlapCheck) ([]overlapApiModel, error) {
+	payload := overlapRequestApiModel{
+		Space: space,
+		Cidrs: make([]string, len(checks)),
+	}
+	for i, check := range checks {
+		payload.Cidrs[i] = check.cidr
+	}
+
+	var response []overlapApiModel
+	if err := c.doJSON(ctx, "POST", fmt.Sprintf("%s/api/tools/vnetOverlap", c.HostURL), payload, &response); err != nil {
+		return nil, err
+	}
+
+	overlaps := make([]overlapApiModel, 0, len(response))
+	for _, overlap := range response {
+		if overlap.Kind == overlapKindVnet && isOwnVnet(checks, overlap) {
+			continue
+		}
+		overlaps = append(overlaps, overlap)
+	}
+
+	return overlaps, nil
+}
+
+// overlapEngineUnavailable reports whether err means that the engine has no
+// vnetOverlap tool, as with engine versions predating it.
+func overlapEngineUnavailable(err error) bool {
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+
+	return apiErr.StatusCode == http.StatusNotFound || apiErr.StatusCode == http.StatusMethodNotAllowed
+}
+
+// overlapLocalCheck computes the overlaps against every Block, unsettled
+// reservation, associated Virtual Network and External Network of the Space.
+func (c *Client) overlapLocalCheck(ctx context.Context, space string, checks []overlapCheck) ([]overlapApiModel, error) {
+	response, err := c.spaceGet(ctx, space)
+	if err != nil {
+		return nil, err
+	}
+
+	overlaps := []overlapApiModel{}
+	for _, check := range checks {
+		for _, block := range response.Blocks {
+			add := func(kind, name, other string) {
+				// Networks the engine reports with an unparsable CIDR cannot overlap.
+				prefix, err := cidr.Parse(other)
+				if err != nil || !prefix.Overlaps(check.prefix) {
+					return
+				}
+				overlaps = append(overlaps, overlapApiModel{
+					Cidr:            check.cidr,
+					OverlappingCidr: other,
+					Kind:            kind,
+					Name:            name,
+					Block:           block.Name,
+				})
+			}
+
+			add(overlapKindBlock, block.Name, block.Cidr)
+
+			for _, resv := range block.Resv {
+				// Settled reservations are represented by their Virtual Network.
+				if resv.SettledOn != 0 {
+					continue
+				}
+				add(overlapKindReservation, resv.Id, resv.CIDR)
+			}
+
+			for _, vnet := range block.Vnets {
+				if check.vnetId != "" && strings.EqualFold(vnet.Id, check.vnetId) {
+					continue
+				}
+				for _, prefix := range vnet.Prefixes {
+					add(overlapKindVnet, vnet.Id, prefix)
+				}
+			}
+
+			for _, external := range block.Externals {
+				add(overlapKindExternal, external.Name, external.Cidr)
+			}
+		}
+	}
+
+	return overlaps, nil
+}
+
+// isOwnVnet reports whether overlap is the Virtual Network the checked CIDR
+// was taken from.
+func isOwnVnet(checks []overlapCheck, overlap overlapApiModel) bool {
+	for _, check := range checks {
+		if check.cidr == overlap.Cidr && check.vnetId != "" && strings.EqualFold(check.vnetId, overlap.Name) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// findVnet looks up a Virtual Network by its resource ID, which Azure treats
+// case-insensitively.
+func findVnet(vnets []vnetApiModel, id string) (vnetApiModel, bool) {
+	for _, vnet := range vnets {
+		if strings.EqualFold(vnet.Id, id) {
+			return vnet, true
+		}
+	}
+
+	return vnetApiModel{}, false
+}
@@ -0,0 +1,184 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"strings"
+	"terraform-provider-azureipam/internal/cidr"
+	"terraform-provider-azureipam/internal/gen/data_sources"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// reservationStatusWait is the status of a Reservation that has not been
+// fulfilled by a Virtual Network yet.
+const reservationStatusWait = "wait"
+
+// vnetPrefix is a parsed address prefix of a discovered Virtual Network.
+type vnetPrefix struct {
+	vnet   vnetApiModel
+	cidr   string
+	prefix netip.Prefix
+}
+
+// ReconciliationApiGet compares the Virtual Networks discovered by the engine
+// with the Blocks and Reservations of a Space.
+func (c *Client) ReconciliationApiGet(ctx context.Context, data *data_sources.ReconciliationModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	space, err := c.spaceGet(ctx, data.Space.ValueString())
+	if err != nil {
+		diags.AddError("API Request Error", fmt.Sprintf("API request failed: %s", err))
+		return diags
+	}
+
+	vnets, err := c.azureVnetsGet(ctx)
+	if err != nil {
+		diags.AddError("API Request Error", fmt.Sprintf("API request failed: %s", err))
+		return diags
+	}
+
+	// Prefixes the engine reports that cannot be parsed are ignored.
+	var prefixes []vnetPrefix
+	for _, vnet := range vnets {
+		for _, v := range vnet.Prefixes {
+			if prefix, err := cidr.Parse(v); err == nil {
+				prefixes = append(prefixes, vnetPrefix{vnet: vnet, cidr: v, prefix: prefix})
+			}
+		}
+	}
+
+	unassociated := []attr.Value{}
+	pending := []attr.Value{}
+	inSpace := []vnetPrefix{}
+	seen := map[string]bool{}
+
+	for _, block := range space.Blocks {
+		blockPrefix, err := cidr.Parse(block.Cidr)
+		if err != nil {
+			continue
+		}
+
+		for _, p := range prefixes {
+			if !p.prefix.Overlaps(blockPrefix) {
+				continue
+			}
+
+			// A prefix overlapping several Blocks is only checked for overlaps
+			// once.
+			if key := strings.ToLower(p.vnet.Id + "|" + p.cidr); !seen[key] {
+				seen[key] = true
+				inSpace = append(inSpace, p)
+			}
+
+			if !cidr.Contains(blockPrefix, p.prefix) || isBlockVnet(block, p.vnet.Id) {
+				continue
+			}
+
+			objVal, objDiags := data_sources.NewUnassociatedVnetsValue(data_sources.NewUnassociatedVnetsValueNull().AttributeTypes(ctx),
+				map[string]attr.Value{
+					"id":     types.StringValue(p.vnet.Id),
+					"name":   types.StringValue(p.vnet.Name),
+					"prefix": types.StringValue(p.cidr),
+					"block":  types.StringValue(block.Name),
+				},
+			)
+			diags.Append(objDiags...)
+			if diags.HasError() {
+				return diags
+			}
+			unassociated = append(unassociated, objVal)
+		}
+
+		for _, resv := range block.Resv {
+			if resv.Status != reservationStatusWait || hasVnetWithin(prefixes, resv.CIDR) {
+				continue
+			}
+
+			objVal, objDiags := data_sources.NewPendingReservationsValue(data_sources.NewPendingReservationsValueNull().AttributeTypes(ctx),
+				map[string]attr.Value{
+					"id":    types.StringValue(resv.Id),
+					"block": types.StringValue(block.Name),
+					"cidr":  types.StringValue(resv.CIDR),
+					"desc":  types.StringValue(reservationDesc(resv.Desc)),
+				},
+			)
+			diags.Append(objDiags...)
+			if diags.HasError() {
+				return diags
+			}
+			pending = append(pending, objVal)
+		}
+	}
+
+	overlapping := []attr.Value{}
+	for i, a := range inSpace {
+		for _, b := range inSpace[i+1:] {
+			if strings.EqualFold(a.vnet.Id, b.vnet.Id) || !a.prefix.Overlaps(b.prefix) {
+				continue
+			}
+
+			objVal, objDiags := data_sources.NewOverlappingVnetsValue(data_sources.NewOverlappingVnetsValueNull().AttributeTypes(ctx),
+				map[string]attr.Value{
+					"vnet_id":             types.StringValue(a.vnet.Id),
+					"prefix":              types.StringValue(a.cidr),
+					"overlapping_vnet_id": types.StringValue(b.vnet.Id),
+					"overlapping_prefix":  types.StringValue(b.cidr),
+				},
+			)
+			diags.Append(objDiags...)
+			if diags.HasError() {
+				return diags
+			}
+			overlapping = append(overlapping, objVal)
+		}
+	}
+
+	unassociatedList, listDiags := types.ListValue(data_sources.NewUnassociatedVnetsValueNull().Type(ctx), unassociated)
+	diags.Append(listDiags...)
+	pendingList, listDiags := types.ListValue(data_sources.NewPendingReservationsValueNull().Type(ctx), pending)
+	diags.Append(listDiags...)
+	overlappingList, listDiags := types.ListValue(data_sources.NewOverlappingVnetsValueNull().Type(ctx), overlapping)
+	diags.Append(listDiags...)
+	if diags.HasError() {
+		return diags
+	}
+
+	data.UnassociatedVnets = unassociatedList
+	data.PendingReservations = pendingList
+	data.OverlappingVnets = overlappingList
+	data.HasFindings = types.BoolValue(len(unassociated)+len(pending)+len(overlapping) > 0)
+
+	return diags
+}
+
+// isBlockVnet reports whether the Virtual Network is associated with block.
+func isBlockVnet(block blockApiModel, id string) bool {
+	for _, vnet := range block.Vnets {
+		if strings.EqualFold(vnet.Id, id) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// hasVnetWithin reports whether any discovered Virtual Network has an address
+// prefix within the CIDR s.
+func hasVnetWithin(prefixes []vnetPrefix, s string) bool {
+	outer, err := cidr.Parse(s)
+	if err != nil {
+		return false
+	}
+
+	for _, p := range prefixes {
+		if cidr.Contains(outer, p.prefix) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -3,19 +3,48 @@ package client
 import (
 	"bytes"
 	"context"
+	"crypto/rand"
+	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"math/big"
 	"net/http"
+	"net/netip"
+	"regexp"
+	"sort"
 	"strings"
+	"terraform-provider-azureipam/internal/cidr"
 	"terraform-provider-azureipam/internal/gen/data_sources"
 	"terraform-provider-azureipam/internal/gen/resources"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
+// blockSelectorMostFree tries the Blocks of a Reservation with the most free
+// addresses first.
+const blockSelectorMostFree = "most_free"
+
+// The on_destroy behaviours of a Reservation.
+const (
+	OnDestroyDelete        = "delete"
+	OnDestroyAbandon       = "abandon"
+	OnDestroyFailIfSettled = "fail_if_settled"
+)
+
+// reservationStatusCancelled is the status of a Reservation deleted through
+// the engine, which keeps it in its Block.
+const reservationStatusCancelled = "cancelledByUser"
+
+// reservationStatusFulfilled is the status of a Reservation settled by a
+// Virtual Network using its range.
+const reservationStatusFulfilled = "fulfilled"
+
 type reservationApiModel struct {
 	Id            string            `json:"id,omitempty"`
 	Space         string            `json:"space,omitempty"`
@@ -70,19 +99,27 @@ func (c *Client) ReservationsApiGet(ctx context.Context, data *data_sources.Rese
 		createdOn := big.NewFloat(reservation.CreatedOn)
 		settledOn := big.NewFloat(reservation.SettledOn)
 		tag, _ := types.MapValue(types.StringType, tags)
+		network := reservationNetworkValues(reservation.CIDR)
 		objVal, objDiags := data_sources.NewReservationsValue(data_sources.NewReservationsValueNull().AttributeTypes(ctx),
 			map[string]attr.Value{
-				"id":         types.StringValue(reservation.Id),
-				"space":      types.StringValue(reservation.Space),
-				"block":      types.StringValue(reservation.Block),
-				"cidr":       types.StringValue(reservation.CIDR),
-				"desc":       types.StringValue(reservation.Desc),
-				"created_on": types.NumberValue(createdOn),
-				"created_by": types.StringValue(reservation.CreatedBy),
-				"settled_by": types.StringValue(reservation.SettledBy),
-				"settled_on": types.NumberValue(settledOn),
-				"status":     types.StringValue(reservation.Status),
-				"tag":        tag,
+				"id":              types.StringValue(reservation.Id),
+				"space":           types.StringValue(reservation.Space),
+				"block":           types.StringValue(reservation.Block),
+				"cidr":            types.StringValue(reservation.CIDR),
+				"desc":            types.StringValue(reservationDesc(reservation.Desc)),
+				"created_on":      types.NumberValue(createdOn),
+				"created_by":      types.StringValue(reservation.CreatedBy),
+				"settled_by":      types.StringValue(reservation.SettledBy),
+				"settled_on":      types.NumberValue(settledOn),
+				"status":          types.StringValue(reservation.Status),
+				"tag":             tag,
+				"ip_version":      ipVersionValue(reservation.CIDR),
+				"network_address": network.NetworkAddress,
+				"netmask":         network.Netmask,
+				"prefix_length":   network.PrefixLength,
+				"first_usable":    network.FirstUsable,
+				"last_usable":     network.LastUsable,
+				"address_count":   network.AddressCount,
 			},
 		)
 		diags.Append(objDiags...)
@@ -91,8 +128,6 @@ func (c *Client) ReservationsApiGet(ctx context.Context, data *data_sources.Rese
 		}
 		elements[i] = objVal
 	}
-	fmt.Println("here")
-	fmt.Println(elements)
 
 	// Set the Reservations field in the ReservationsModel
 	data.Reservations, diags = types.SetValueFrom(ctx, data_sources.NewReservationsValueNull().Type(ctx), &elements)
@@ -119,53 +154,629 @@ func (c *Client) ReservationApiGet(ctx context.Context, data *data_sources.Reser
 	return mapApiResponseToModel(response, data)
 }
 
-// ReservationApiGetDelete handles GET and DELETE requests for reservations
-func (c *Client) ReservationApiGetDelete(ctx context.Context, data *resources.ReservationModel, method string) diag.Diagnostics {
+// ReservationApiGetDelete handles GET and DELETE requests for reservations. It
+// reports whether the Reservation still exists: one the engine no longer
+// knows is not an error, so that it can be removed from the state.
+func (c *Client) ReservationApiGetDelete(ctx context.Context, data *resources.ReservationModel, method string) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	block := allocatedBlock(data)
+
 	payload := reservationApiModel{
 		Space: data.Space.ValueString(),
-		Block: data.Block.ValueString(),
+		Block: block,
 		Id:    data.Id.ValueString(),
 	}
 
 	url := fmt.Sprintf("%s/api/spaces/%s/blocks/%s/reservations/%s",
-		c.HostURL, strings.Trim(data.Space.ValueString(), "\""), strings.Trim(data.Block.ValueString(), "\""), strings.Trim(data.Id.ValueString(), "\""))
+		c.HostURL, strings.Trim(data.Space.ValueString(), "\""), strings.Trim(block, "\""), strings.Trim(data.Id.ValueString(), "\""))
 
 	if method == "DELETE" {
-		_, diags := c.reservationExecuteRequest(ctx, method, url, payload)
+		_, diags = c.reservationExecuteRequest(ctx, method, url, payload)
+		return !diags.HasError(), diags
+	}
+
+	var response reservationApiModel
+	if err := c.doJSON(ctx, method, url, nil, &response); err != nil {
+		if isNotFound(err) {
+			return false, diags
+		}
+		diags.AddError("API Request Error", fmt.Sprintf("API request failed: %s", err))
+		return false, diags
+	}
+
+	data.AllocatedBlock = types.StringValue(block)
+	c.reservationHold(response.Id)
+
+	return true, mapApiResponseToModel(response, data)
+}
+
+// ReservationApiDestroy destroys a Reservation according to on_destroy. A
+// Reservation still waiting for a Virtual Network is deleted, unless it is
+// abandoned. Once settled it is only deleted with force_delete; otherwise
+// delete leaves it in its Block and fail_if_settled fails the destroy.
+func (c *Client) ReservationApiDestroy(ctx context.Context, data *resources.ReservationModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	space := data.Space.ValueString()
+	block := allocatedBlock(data)
+	id := data.Id.ValueString()
+
+	if data.OnDestroy.ValueString() == OnDestroyAbandon {
+		diags.AddWarning(
+			"Reservation Abandoned",
+			fmt.Sprintf("Reservation %s of %s was removed from the state without being deleted. "+
+				"It still holds its range in Block %s of Space %s.", id, data.Cidr.ValueString(), block, space),
+		)
+		return diags
+	}
+
+	url := fmt.Sprintf("%s/api/spaces/%s/blocks/%s/reservations/%s",
+		c.HostURL, strings.Trim(space, "\""), strings.Trim(block, "\""), strings.Trim(id, "\""))
+
+	var response reservationApiModel
+	if err := c.doJSON(ctx, "GET", url, nil, &response); err != nil {
+		if isNotFound(err) {
+			return diags
+		}
+		diags.AddError("API Request Error", fmt.Sprintf("API request failed: %s", err))
+		return diags
+	}
+
+	if response.Status == reservationStatusCancelled {
+		return diags
+	}
+
+	// Any status other than fulfilled, such as one reporting an error while
+	// settling, leaves the range unused and the Reservation is deleted.
+	settled := response.Status == reservationStatusFulfilled
+	detail := fmt.Sprintf("Reservation %s of %s in Block %s of Space %s has status %s", id, response.CIDR, block, space, response.Status)
+	if response.SettledBy != "" {
+		detail += fmt.Sprintf(", settled by %s", response.SettledBy)
+	}
+	detail += "."
+
+	if settled && !data.ForceDelete.ValueBool() {
+		if data.OnDestroy.ValueString() == OnDestroyFailIfSettled {
+			diags.AddError(
+				"Reservation Already Settled",
+				detail+" It was not deleted. Set on_destroy to abandon to remove it from the state only, or force_delete to delete it anyway.",
+			)
+			return diags
+		}
+
+		diags.AddWarning(
+			"Reservation Already Settled",
+			detail+" It was removed from the state without being deleted, and its range stays in use by the Virtual Network. "+
+				"Set force_delete to delete settled Reservations.",
+		)
 		return diags
 	}
 
-	response, diags := c.reservationExecuteRequest(ctx, method, url, payload)
+	payload := reservationApiModel{
+		Space: space,
+		Block: block,
+		Id:    id,
+	}
+
+	_, diags = c.reservationExecuteRequest(ctx, "DELETE", url, payload)
 	if diags.HasError() {
 		return diags
 	}
 
-	return mapApiResponseToModel(response, data)
+	if settled {
+		diags.AddWarning(
+			"Settled Reservation Deleted",
+			detail+" It was deleted because force_delete is set. Deleting it does not release the range from the Virtual Network using it.",
+		)
+	}
+
+	return diags
 }
 
-// ReservationApiPost handles POST requests for reservations
+// ReservationApiPost handles POST requests for reservations. When several
+// Blocks are configured they are tried in turn until one can hold the
+// Reservation, and the Block used is recorded in allocated_block.
 func (c *Client) ReservationApiPost(ctx context.Context, data *resources.ReservationModel) diag.Diagnostics {
-	payload := reservationApiModel{
-		Space:         data.Space.ValueString(),
-		Block:         data.Block.ValueString(),
-		SmallestCidr:  data.SmallestCidr.ValueBool(),
-		ReverseSearch: data.ReverseSearch.ValueBool(),
-		Size:          data.Size.ValueInt64(),
-		Desc:          data.Desc.ValueString(),
-		CIDR:          data.Cidr.ValueString(),
+	space := data.Space.ValueString()
+
+	blocks, diags := c.reservationBlocks(ctx, data)
+	if diags.HasError() {
+		return diags
+	}
+
+	within, exclude, constraintDiags := reservationConstraints(ctx, data)
+	diags.Append(constraintDiags...)
+	if diags.HasError() {
+		return diags
+	}
+
+	// A configured key may already be stamped on a Reservation created by an
+	// apply which stopped before the state was written, which is adopted.
+	if !data.IdempotencyKey.IsNull() && !data.IdempotencyKey.IsUnknown() {
+		adopted, adoptDiags := c.reservationAdopt(ctx, space, blocks, data)
+		diags.Append(adoptDiags...)
+		if adopted || diags.HasError() {
+			return diags
+		}
+	} else {
+		key, err := newIdempotencyKey()
+		if err != nil {
+			diags.AddError("Failed to Generate Idempotency Key", err.Error())
+			return diags
+		}
+		data.IdempotencyKey = types.StringValue(key)
+	}
+	key := data.IdempotencyKey.ValueString()
+
+	// A preferred CIDR is tried in the Block containing it before any Block
+	// is asked for a range of its size.
+	preferred := ""
+	if !data.PreferredCidr.IsNull() && !data.PreferredCidr.IsUnknown() {
+		prefix, err := cidr.Parse(data.PreferredCidr.ValueString())
+		if err != nil {
+			diags.AddAttributeError(path.Root("preferred_cidr"), "Invalid CIDR", err.Error())
+			return diags
+		}
+		preferred = prefix.String()
+		data.Size = types.Int64Value(int64(prefix.Bits()))
+
+		response, block, err := c.reservationPostPreferred(ctx, space, blocks, key, prefix, data.Desc.ValueString())
+		if err != nil {
+			diags.AddError("API request failed", err.Error())
+			return diags
+		}
+
+		if block != "" {
+			data.AllocatedBlock = types.StringValue(block)
+			diags.Append(mapApiResponseToModel(response, data)...)
+			return diags
+		}
+	}
+
+	var failures []string
+	for _, block := range blocks {
+		// The engine allocates from the Block, so a requested IP version can
+		// only be honoured when it matches the Block's.
+		if !data.IpVersion.IsNull() && !data.IpVersion.IsUnknown() {
+			if versionDiags := c.reservationBlockVersionCheck(ctx, space, block, data.IpVersion.ValueInt64(), path.Root("ip_version")); versionDiags.HasError() {
+				if len(blocks) == 1 {
+					diags.Append(versionDiags...)
+					return diags
+				}
+				failures = append(failures, versionDiags.Errors()[0].Detail())
+				continue
+			}
+		}
+
+		payload := reservationApiModel{
+			Space:         space,
+			Block:         block,
+			SmallestCidr:  data.SmallestCidr.ValueBool(),
+			ReverseSearch: data.ReverseSearch.ValueBool(),
+			Size:          data.Size.ValueInt64(),
+			Desc:          data.Desc.ValueString(),
+			CIDR:          data.Cidr.ValueString(),
+		}
+
+		// With constraints the prefix is chosen here and reserved by CIDR, as
+		// the engine cannot honour them itself.
+		if within.IsValid() || len(exclude) > 0 {
+			prefix, found, err := c.reservationConstrainedPrefix(ctx, space, block, data, within, exclude)
+			if err != nil {
+				diags.AddError("API Request Error", fmt.Sprintf("API request failed: %s", err))
+				return diags
+			}
+
+			if !found {
+				detail := fmt.Sprintf("no free /%d prefix of Block %s satisfies within_cidr and exclude_cidrs", data.Size.ValueInt64(), block)
+				if len(blocks) == 1 {
+					diags.AddError("No Free Prefix", detail)
+					return diags
+				}
+				failures = append(failures, detail)
+				continue
+			}
+
+			payload.CIDR = prefix.String()
+			payload.Size = 0
+			payload.SmallestCidr = false
+			payload.ReverseSearch = false
+		}
+
+		url := fmt.Sprintf("%s/api/spaces/%s/blocks/%s/reservations", c.HostURL, strings.Trim(space, "\""), strings.Trim(block, "\""))
+
+		response, err := c.reservationPostIdempotent(ctx, url, key, payload)
+		if err == nil {
+			if preferred != "" {
+				diags.AddAttributeWarning(
+					path.Root("preferred_cidr"),
+					"Preferred CIDR Unavailable",
+					fmt.Sprintf("%s could not be reserved, %s was reserved in Block %s instead.", preferred, response.CIDR, block),
+				)
+			}
+
+			data.AllocatedBlock = types.StringValue(block)
+			diags.Append(mapApiResponseToModel(response, data)...)
+			return diags
+		}
+
+		// Only a Block rejecting the Reservation is a reason to try the next
+		// one; anything else would most likely fail for every Block.
+		apiErr, ok := blockRejection(err)
+		if len(blocks) == 1 || !ok {
+			diags.AddError("API request failed", err.Error())
+			return diags
+		}
+		failures = append(failures, fmt.Sprintf("%s: %s", block, apiErr.Body))
+	}
+
+	diags.AddError(
+		"No Block Could Hold the Reservation",
+		fmt.Sprintf("None of the Blocks of Space %s could hold the Reservation:\n%s", space, strings.Join(failures, "\n")),
+	)
+
+	return diags
+}
+
+// reservationPostPreferred reserves the preferred prefix in the first of the
+// Blocks containing it. It returns the Block used, or an empty Block when no
+// Block contains the prefix or it is not free.
+func (c *Client) reservationPostPreferred(ctx context.Context, space string, blocks []string, key string, preferred netip.Prefix, desc string) (reservationApiModel, string, error) {
+	for _, block := range blocks {
+		response, err := c.blockGet(ctx, space, block)
+		if err != nil {
+			return reservationApiModel{}, "", fmt.Errorf("could not retrieve Block %s: %w", block, err)
+		}
+
+		blockPrefix, err := cidr.Parse(response.Cidr)
+		if err != nil || !cidr.Contains(blockPrefix, preferred) {
+			continue
+		}
+
+		url := fmt.Sprintf("%s/api/spaces/%s/blocks/%s/reservations", c.HostURL, strings.Trim(space, "\""), strings.Trim(block, "\""))
+		reservation, err := c.reservationPostIdempotent(ctx, url, key, reservationApiModel{
+			Space: space,
+			Block: block,
+			Desc:  desc,
+			CIDR:  preferred.String(),
+		})
+		if err == nil {
+			return reservation, block, nil
+		}
+
+		if _, ok := blockRejection(err); !ok {
+			return reservationApiModel{}, "", err
+		}
+	}
+
+	return reservationApiModel{}, "", nil
+}
+
+// reservationConstraints returns the within_cidr and exclude_cidrs constraints
+// of a Reservation. within is the zero prefix when it is not set.
+func reservationConstraints(ctx context.Context, data *resources.ReservationModel) (netip.Prefix, []netip.Prefix, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var within netip.Prefix
+	var exclude []netip.Prefix
+
+	if !data.WithinCidr.IsNull() && !data.WithinCidr.IsUnknown() {
+		prefix, err := cidr.Parse(data.WithinCidr.ValueString())
+		if err != nil {
+			diags.AddAttributeError(path.Root("within_cidr"), "Invalid CIDR", err.Error())
+			return within, nil, diags
+		}
+		within = prefix
+	}
+
+	if !data.ExcludeCidrs.IsNull() && !data.ExcludeCidrs.IsUnknown() {
+		var excludeCidrs []string
+		diags.Append(data.ExcludeCidrs.ElementsAs(ctx, &excludeCidrs, false)...)
+		if diags.HasError() {
+			return within, nil, diags
+		}
+
+		for _, v := range excludeCidrs {
+			prefix, err := cidr.Parse(v)
+			if err != nil {
+				diags.AddAttributeError(path.Root("exclude_cidrs"), "Invalid CIDR", err.Error())
+				return within, nil, diags
+			}
+			exclude = append(exclude, prefix)
+		}
+	}
+
+	return within, exclude, diags
+}
+
+// reservationConstrainedPrefix picks a free prefix of the Reservation's size
+// in the Block, within the within prefix when it is valid and not overlapping
+// any of the excluded prefixes. Availability is computed from the networks
+// and unsettled reservations of the Block. It reports false when no prefix
+// satisfies the constraints.
+func (c *Client) reservationConstrainedPrefix(ctx context.Context, space, block string, data *resources.ReservationModel, within netip.Prefix, exclude []netip.Prefix) (netip.Prefix, bool, error) {
+	response, err := c.blockGet(ctx, space, block)
+	if err != nil {
+		return netip.Prefix{}, false, fmt.Errorf("could not retrieve Block %s: %w", block, err)
+	}
+
+	free, err := blockFree(response)
+	if err != nil {
+		return netip.Prefix{}, false, err
+	}
+
+	if within.IsValid() {
+		free = cidr.Within(free, within)
+	}
+	free = cidr.Exclude(free, exclude)
+
+	prefix, ok := cidr.Pick(free, int(data.Size.ValueInt64()), data.ReverseSearch.ValueBool(), data.SmallestCidr.ValueBool())
+
+	return prefix, ok, nil
+}
+
+// reservationPostIdempotent creates a Reservation stamped with the idempotency
+// key. When the request fails without an answer from the engine, or with a
+// server error, the Reservation may have been created before the response was
+// lost, so the Block is searched for it before giving up.
+func (c *Client) reservationPostIdempotent(ctx context.Context, url, key string, payload reservationApiModel) (reservationApiModel, error) {
+	payload.Desc = stampIdempotencyKey(payload.Desc, key)
+
+	start := time.Now()
+
+	var response reservationApiModel
+	err := c.doJSON(ctx, "POST", url, payload, &response)
+	if err == nil {
+		return response, nil
+	}
+
+	var apiErr *APIError
+	if errors.As(err, &apiErr) && apiErr.StatusCode < http.StatusInternalServerError {
+		return reservationApiModel{}, err
+	}
+
+	existing, found, findErr := c.reservationFindByKey(ctx, payload.Space, payload.Block, key, start.Add(-reservationClockSkew))
+	if findErr != nil || !found {
+		return reservationApiModel{}, err
+	}
+
+	tflog.Info(ctx, "Adopted Reservation created by a failed request", map[string]interface{}{
+		"id":    existing.Id,
+		"error": err.Error(),
+	})
+
+	return existing, nil
+}
+
+// reservationAdopt looks in the Blocks for an unsettled Reservation stamped
+// with the configured idempotency key and records it in data. Reservations in
+// the state, such as the one being replaced, are never adopted. A Reservation
+// that does not match the configuration is an error rather than reserving a
+// second one with the same key.
+func (c *Client) reservationAdopt(ctx context.Context, space string, blocks []string, data *resources.ReservationModel) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	key := data.IdempotencyKey.ValueString()
+
+	for _, block := range blocks {
+		existing, found, err := c.reservationFindByKey(ctx, space, block, key, time.Time{})
+		if err != nil {
+			diags.AddError("API Request Error", fmt.Sprintf("API request failed: %s", err))
+			return false, diags
+		}
+
+		if !found {
+			continue
+		}
+
+		if mismatch := reservationMismatch(existing, data); mismatch != "" {
+			diags.AddAttributeError(
+				path.Root("idempotency_key"),
+				"Idempotency Key In Use",
+				fmt.Sprintf("Reservation %s of Block %s is stamped with idempotency key %q but its %s does not match the configuration. Delete it or change the key.", existing.Id, block, key, mismatch),
+			)
+			return false, diags
+		}
+
+		tflog.Info(ctx, "Adopted Reservation stamped with the idempotency key", map[string]interface{}{
+			"id":    existing.Id,
+			"block": block,
+		})
+
+		data.AllocatedBlock = types.StringValue(block)
+		c.reservationHold(existing.Id)
+		diags.Append(mapApiResponseToModel(existing, data)...)
+
+		return true, diags
+	}
+
+	return false, diags
+}
+
+// reservationMismatch names the attribute of a Reservation which differs from
+// the configuration, or returns an empty string when it matches.
+func reservationMismatch(existing reservationApiModel, data *resources.ReservationModel) string {
+	prefix, err := cidr.Parse(existing.CIDR)
+	if err != nil {
+		return "cidr"
 	}
 
-	url := fmt.Sprintf("%s/api/spaces/%s/blocks/%s/reservations", c.HostURL, strings.Trim(data.Space.ValueString(), "\""), strings.Trim(data.Block.ValueString(), "\""))
+	switch {
+	case reservationDesc(existing.Desc) != data.Desc.ValueString():
+		return "desc"
+	case !data.Cidr.IsNull() && !data.Cidr.IsUnknown() && data.Cidr.ValueString() != prefix.String():
+		return "cidr"
+	case !data.PreferredCidr.IsNull() && !data.PreferredCidr.IsUnknown() && !strings.HasSuffix(data.PreferredCidr.ValueString(), fmt.Sprintf("/%d", prefix.Bits())):
+		return "size"
+	case !data.Size.IsNull() && !data.Size.IsUnknown() && data.Size.ValueInt64() != int64(prefix.Bits()):
+		return "size"
+	}
+
+	return ""
+}
+
+// reservationHold records that a Reservation is in the state.
+func (c *Client) reservationHold(id string) {
+	if id == "" {
+		return
+	}
+
+	c.heldMu.Lock()
+	defer c.heldMu.Unlock()
+
+	if c.held == nil {
+		c.held = map[string]bool{}
+	}
+	c.held[id] = true
+}
+
+// reservationHeld reports whether a Reservation is in the state.
+func (c *Client) reservationHeld(id string) bool {
+	c.heldMu.Lock()
+	defer c.heldMu.Unlock()
+
+	return c.held[id]
+}
+
+// reservationClockSkew is how far the clock of the engine may be behind ours
+// when telling whether a Reservation was created by a request.
+const reservationClockSkew = time.Minute
+
+// reservationFindByKey looks for the unsettled Reservation of a Block stamped
+// with the idempotency key and created after since. Reservations in the
+// state belong to another instance, such as the one being replaced, and are
+// never returned.
+func (c *Client) reservationFindByKey(ctx context.Context, space, block, key string, since time.Time) (reservationApiModel, bool, error) {
+	var reservations []reservationApiModel
+
+	url := fmt.Sprintf("%s/api/spaces/%s/blocks/%s/reservations", c.HostURL, strings.Trim(space, "\""), strings.Trim(block, "\""))
+	if err := c.doJSON(ctx, "GET", url, nil, &reservations); err != nil {
+		return reservationApiModel{}, false, err
+	}
 
-	response, diags := c.reservationExecuteRequest(ctx, "POST", url, payload)
+	for _, reservation := range reservations {
+		if reservation.SettledOn != 0 || reservationIdempotencyKey(reservation.Desc) != key || c.reservationHeld(reservation.Id) {
+			continue
+		}
+
+		if reservation.CreatedOn < float64(since.Unix()) {
+			continue
+		}
+
+		return reservation, true, nil
+	}
+
+	return reservationApiModel{}, false, nil
+}
+
+// blockRejection reports whether err is the engine rejecting a Reservation
+// because the Block cannot hold it: the requested CIDR overlaps an existing
+// network, or no network of the requested size is free. The engine answers
+// both with 409 Conflict. Any other error, such as a malformed request, would
+// fail the same way for every Block.
+func blockRejection(err error) (*APIError, bool) {
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusConflict {
+		return nil, false
+	}
+
+	return apiErr, true
+}
+
+// ReservationBlockNames returns the Blocks configured for a Reservation,
+// either its single block or its list of blocks.
+func ReservationBlockNames(ctx context.Context, data *resources.ReservationModel) ([]string, diag.Diagnostics) {
+	if !data.Block.IsNull() {
+		return []string{data.Block.ValueString()}, nil
+	}
+
+	var blocks []string
+	diags := data.Blocks.ElementsAs(ctx, &blocks, false)
+
+	return blocks, diags
+}
+
+// reservationBlocks returns the Blocks to try for a Reservation, in order.
+// With the most_free selector, Blocks with more free addresses come first.
+func (c *Client) reservationBlocks(ctx context.Context, data *resources.ReservationModel) ([]string, diag.Diagnostics) {
+	blocks, diags := ReservationBlockNames(ctx, data)
+	if diags.HasError() || data.BlockSelector.ValueString() != blockSelectorMostFree {
+		return blocks, diags
+	}
+
+	free := make(map[string]*big.Int, len(blocks))
+	for _, block := range blocks {
+		response, err := c.blockGet(ctx, data.Space.ValueString(), block)
+		if err != nil {
+			diags.AddError("API Request Error", fmt.Sprintf("API request failed: %s", err))
+			return nil, diags
+		}
+
+		prefixes, err := blockFree(response)
+		if err != nil {
+			diags.AddError("Invalid Block CIDR", err.Error())
+			return nil, diags
+		}
+		free[block] = cidr.Count(prefixes)
+	}
+
+	sort.SliceStable(blocks, func(i, j int) bool {
+		return free[blocks[i]].Cmp(free[blocks[j]]) > 0
+	})
+
+	return blocks, diags
+}
+
+// allocatedBlock returns the Block a Reservation was allocated from. States
+// written before allocated_block existed only have block.
+func allocatedBlock(data *resources.ReservationModel) string {
+	if data.AllocatedBlock.IsNull() || data.AllocatedBlock.IsUnknown() {
+		return data.Block.ValueString()
+	}
+
+	return data.AllocatedBlock.ValueString()
+}
+
+// reservationBlockVersionCheck ensures the Block is of the requested IP version.
+// A mismatch is reported on attrPath, the attribute that requested it.
+func (c *Client) reservationBlockVersionCheck(ctx context.Context, space, block string, version int64, attrPath path.Path) diag.Diagnostics {
+	blockVersion, diags := c.BlockIpVersion(ctx, space, block)
 	if diags.HasError() {
 		return diags
 	}
 
-	return mapApiResponseToModel(response, data)
+	if blockVersion != version {
+		diags.AddAttributeError(
+			attrPath,
+			"IP Version Mismatch",
+			fmt.Sprintf("Block %s cannot hold IPv%d Reservations.", block, version),
+		)
+	}
+
+	return diags
+}
+
+// BlockIpVersion returns the IP version of a Block, 4 or 6.
+func (c *Client) BlockIpVersion(ctx context.Context, space, block string) (int64, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	response, err := c.blockGet(ctx, space, block)
+	if err != nil {
+		diags.AddError("API Request Error", fmt.Sprintf("API request failed: %s", err))
+		return 0, diags
+	}
+
+	prefix, err := cidr.Parse(response.Cidr)
+	if err != nil {
+		diags.AddError("Invalid Block CIDR", err.Error())
+		return 0, diags
+	}
+
+	return cidr.Version(prefix), diags
 }
 
-// reservationExecuteRequest handles making the HTTP request and unmarshalling the response
+// reservationExecuteRequest handles making the HTTP request and unmarshalling
+// the response. Deleting a Reservation the engine no longer knows succeeds.
 func (c *Client) reservationExecuteRequest(ctx context.Context, method, url string, payload reservationApiModel) (reservationApiModel, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
@@ -186,6 +797,9 @@ func (c *Client) reservationExecuteRequest(ctx context.Context, method, url stri
 
 	// Execute the request
 	respBody, err := c.DoRequest(req, &c.Token)
+	if err != nil && method == "DELETE" && isNotFound(err) {
+		return reservationApiModel{}, diags
+	}
 	if err != nil {
 		select {
 		case <-ctx.Done(): // Handle context cancellation or timeout
@@ -219,8 +833,17 @@ func mapApiResponseToModel(response reservationApiModel, model interface{}) diag
 		data.Id = types.StringValue(response.Id)
 		data.Cidr = types.StringValue(response.CIDR)
 		data.CreatedBy = types.StringValue(response.CreatedBy)
-		data.Desc = types.StringValue(response.Desc)
+		data.Desc = types.StringValue(reservationDesc(response.Desc))
 		data.Status = types.StringValue(response.Status)
+		data.IpVersion = ipVersionValue(response.CIDR)
+
+		network := reservationNetworkValues(response.CIDR)
+		data.NetworkAddress = network.NetworkAddress
+		data.Netmask = network.Netmask
+		data.PrefixLength = network.PrefixLength
+		data.FirstUsable = network.FirstUsable
+		data.LastUsable = network.LastUsable
+		data.AddressCount = network.AddressCount
 
 		settledOnBigFloat := big.NewFloat(response.SettledOn)
 		data.SettledOn = types.NumberValue(settledOnBigFloat)
@@ -242,8 +865,20 @@ func mapApiResponseToModel(response reservationApiModel, model interface{}) diag
 		data.Id = types.StringValue(response.Id)
 		data.Cidr = types.StringValue(response.CIDR)
 		data.CreatedBy = types.StringValue(response.CreatedBy)
-		data.Desc = types.StringValue(response.Desc)
+		data.Desc = types.StringValue(reservationDesc(response.Desc))
+		if key := reservationIdempotencyKey(response.Desc); key != "" {
+			data.IdempotencyKey = types.StringValue(key)
+		}
 		data.Status = types.StringValue(response.Status)
+		data.IpVersion = ipVersionValue(response.CIDR)
+
+		network := reservationNetworkValues(response.CIDR)
+		data.NetworkAddress = network.NetworkAddress
+		data.Netmask = network.Netmask
+		data.PrefixLength = network.PrefixLength
+		data.FirstUsable = network.FirstUsable
+		data.LastUsable = network.LastUsable
+		data.AddressCount = network.AddressCount
 
 		settledOnBigFloat := big.NewFloat(response.SettledOn)
 		data.SettledOn = types.NumberValue(settledOnBigFloat)
@@ -253,6 +888,12 @@ func mapApiResponseToModel(response reservationApiModel, model interface{}) diag
 
 		if response.Size != 0 {
 			data.Size = types.Int64Value(response.Size)
+		} else if data.Size.IsUnknown() {
+			// The engine does not return the size of Reservations requested
+			// by CIDR.
+			if prefix, err := cidr.Parse(response.CIDR); err == nil {
+				data.Size = types.Int64Value(int64(prefix.Bits()))
+			}
 		}
 
 		if response.Tag != nil {
@@ -268,3 +909,86 @@ func mapApiResponseToModel(response reservationApiModel, model interface{}) diag
 
 	return diags
 }
+
+// ipVersionValue returns the IP version of a Reservation CIDR, or null when
+// the engine did not return a valid CIDR.
+func ipVersionValue(s string) types.Int64 {
+	prefix, err := cidr.Parse(s)
+	if err != nil {
+		return types.Int64Null()
+	}
+
+	return types.Int64Value(cidr.Version(prefix))
+}
+
+// reservationNetwork holds the network details derived from a Reservation
+// CIDR.
+type reservationNetwork struct {
+	NetworkAddress types.String
+	Netmask        types.String
+	PrefixLength   types.Int64
+	FirstUsable    types.String
+	LastUsable     types.String
+	AddressCount   types.Number
+}
+
+// reservationNetworkValues derives the network details of a Reservation CIDR.
+// Every value is null when the engine did not return a valid CIDR.
+func reservationNetworkValues(s string) reservationNetwork {
+	prefix, err := cidr.Parse(s)
+	if err != nil {
+		return reservationNetwork{
+			NetworkAddress: types.StringNull(),
+			Netmask:        types.StringNull(),
+			PrefixLength:   types.Int64Null(),
+			FirstUsable:    types.StringNull(),
+			LastUsable:     types.StringNull(),
+			AddressCount:   types.NumberNull(),
+		}
+	}
+
+	first, last := cidr.UsableRange(prefix)
+
+	return reservationNetwork{
+		NetworkAddress: types.StringValue(prefix.Addr().String()),
+		Netmask:        types.StringValue(cidr.Netmask(prefix).String()),
+		PrefixLength:   types.Int64Value(int64(prefix.Bits())),
+		FirstUsable:    types.StringValue(first.String()),
+		LastUsable:     types.StringValue(last.String()),
+		AddressCount:   types.NumberValue(new(big.Float).SetInt(cidr.Size(prefix))),
+	}
+}
+
+// idempotencyKeyPattern matches the idempotency key stamped at the end of the
+// description of a Reservation.
+var idempotencyKeyPattern = regexp.MustCompile(` ?\[idempotency-key:([^\]]+)\]$`)
+
+// newIdempotencyKey returns a random idempotency key.
+func newIdempotencyKey() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(b), nil
+}
+
+// stampIdempotencyKey appends the idempotency key to a description.
+func stampIdempotencyKey(desc, key string) string {
+	return fmt.Sprintf("%s [idempotency-key:%s]", desc, key)
+}
+
+// reservationIdempotencyKey returns the idempotency key stamped in a
+// description, or an empty string.
+func reservationIdempotencyKey(desc string) string {
+	if m := idempotencyKeyPattern.FindStringSubmatch(desc); m != nil {
+		return m[1]
+	}
+
+	return ""
+}
+
+// reservationDesc returns a description without its idempotency key.
+func reservationDesc(desc string) string {
+	return idempotencyKeyPattern.ReplaceAllString(desc, "")
+}
@@ -0,0 +1,225 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"terraform-provider-azureipam/internal/gen/resources"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestReservationApiPostAdopt(t *testing.T) {
+	stamped := reservationApiModel{
+		Id:     "old",
+		CIDR:   "10.0.0.0/24",
+		Desc:   stampIdempotencyKey("New Reservation.", "k"),
+		Status: reservationStatusWait,
+	}
+
+	tests := []struct {
+		name     string
+		existing reservationApiModel
+		held     bool
+		size     int64
+		wantId   string
+		wantPost bool
+		wantErr  string
+	}{
+		{
+			name:     "unsettled Reservation adopted",
+			existing: stamped,
+			size:     24,
+			wantId:   "old",
+		},
+		{
+			name:     "Reservation in the state not adopted",
+			existing: stamped,
+			held:     true,
+			size:     24,
+			wantId:   "new",
+			wantPost: true,
+		},
+		{
+			name: "settled Reservation not adopted",
+			existing: func() reservationApiModel {
+				settled := stamped
+				settled.SettledOn = 1700000000
+				settled.Status = reservationStatusFulfilled
+				return settled
+			}(),
+			size:     24,
+			wantId:   "new",
+			wantPost: true,
+		},
+		{
+			name:     "Reservation of another size",
+			existing: stamped,
+			size:     25,
+			wantErr:  "Idempotency Key In Use",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			posted := false
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				switch r.Method {
+				case "GET":
+					json.NewEncoder(w).Encode([]reservationApiModel{tt.existing})
+				case "POST":
+					posted = true
+					json.NewEncoder(w).Encode(reservationApiModel{Id: "new", CIDR: "10.0.1.0/24", Status: reservationStatusWait})
+				default:
+					http.Error(w, "unexpected method", http.StatusMethodNotAllowed)
+				}
+			}))
+			defer server.Close()
+
+			c := &Client{HostURL: server.URL, HTTPClient: server.Client()}
+			if tt.held {
+				c.reservationHold(tt.existing.Id)
+			}
+
+			data := &resources.ReservationModel{
+				Space:          types.StringValue("s"),
+				Block:          types.StringValue("b"),
+				Size:           types.Int64Value(tt.size),
+				Desc:           types.StringValue("New Reservation."),
+				IdempotencyKey: types.StringValue("k"),
+				Blocks:         types.ListNull(types.StringType),
+				ExcludeCidrs:   types.SetNull(types.StringType),
+			}
+
+			diags := c.ReservationApiPost(context.Background(), data)
+
+			var gotErr string
+			if errs := diags.Errors(); len(errs) > 0 {
+				gotErr = errs[0].Summary()
+			}
+			if gotErr != tt.wantErr {
+				t.Fatalf("ReservationApiPost() error = %q, want %q (diagnostics: %v)", gotErr, tt.wantErr, diags)
+			}
+			if posted != tt.wantPost {
+				t.Errorf("ReservationApiPost() posted = %v, want %v", posted, tt.wantPost)
+			}
+			if tt.wantErr == "" && data.Id.ValueString() != tt.wantId {
+				t.Errorf("ReservationApiPost() id = %q, want %q", data.Id.ValueString(), tt.wantId)
+			}
+		})
+	}
+}
+
+func TestReservationApiDestroy(t *testing.T) {
+	tests := []struct {
+		name        string
+		status      string
+		onDestroy   string
+		forceDelete bool
+		wantDelete  bool
+		wantErr     string
+		wantWarning string
+	}{
+		{
+			name:       "waiting Reservation deleted",
+			status:     reservationStatusWait,
+			onDestroy:  OnDestroyDelete,
+			wantDelete: true,
+		},
+		{
+			name:        "settled Reservation left with delete",
+			status:      reservationStatusFulfilled,
+			onDestroy:   OnDestroyDelete,
+			wantWarning: "Reservation Already Settled",
+		},
+		{
+			name:      "settled Reservation fails with fail_if_settled",
+			status:    reservationStatusFulfilled,
+			onDestroy: OnDestroyFailIfSettled,
+			wantErr:   "Reservation Already Settled",
+		},
+		{
+			name:        "settled Reservation deleted with force_delete",
+			status:      reservationStatusFulfilled,
+			onDestroy:   OnDestroyDelete,
+			forceDelete: true,
+			wantDelete:  true,
+			wantWarning: "Settled Reservation Deleted",
+		},
+		{
+			name:      "cancelled Reservation",
+			status:    reservationStatusCancelled,
+			onDestroy: OnDestroyFailIfSettled,
+		},
+		{
+			name:      "Reservation not found",
+			onDestroy: OnDestroyFailIfSettled,
+		},
+		{
+			name:        "Reservation abandoned",
+			status:      reservationStatusWait,
+			onDestroy:   OnDestroyAbandon,
+			wantWarning: "Reservation Abandoned",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			deleted := false
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/api/spaces/s/blocks/b/reservations/abc" {
+					http.NotFound(w, r)
+					return
+				}
+
+				switch r.Method {
+				case "GET":
+					if tt.status == "" {
+						http.NotFound(w, r)
+						return
+					}
+					json.NewEncoder(w).Encode(reservationApiModel{Id: "abc", CIDR: "10.0.0.0/24", Status: tt.status})
+				case "DELETE":
+					deleted = true
+					w.WriteHeader(http.StatusOK)
+				default:
+					http.Error(w, "unexpected method", http.StatusMethodNotAllowed)
+				}
+			}))
+			defer server.Close()
+
+			c := &Client{HostURL: server.URL, HTTPClient: server.Client()}
+
+			data := &resources.ReservationModel{
+				Space:       types.StringValue("s"),
+				Block:       types.StringValue("b"),
+				Id:          types.StringValue("abc"),
+				Cidr:        types.StringValue("10.0.0.0/24"),
+				OnDestroy:   types.StringValue(tt.onDestroy),
+				ForceDelete: types.BoolValue(tt.forceDelete),
+			}
+
+			diags := c.ReservationApiDestroy(context.Background(), data)
+
+			var gotErr, gotWarning string
+			if errs := diags.Errors(); len(errs) > 0 {
+				gotErr = errs[0].Summary()
+			}
+			if warnings := diags.Warnings(); len(warnings) > 0 {
+				gotWarning = warnings[0].Summary()
+			}
+
+			if gotErr != tt.wantErr {
+				t.Errorf("ReservationApiDestroy() error = %q, want %q (diagnostics: %v)", gotErr, tt.wantErr, diags)
+			}
+			if gotWarning != tt.wantWarning {
+				t.Errorf("ReservationApiDestroy() warning = %q, want %q", gotWarning, tt.wantWarning)
+			}
+			if deleted != tt.wantDelete {
+				t.Errorf("ReservationApiDestroy() deleted = %v, want %v", deleted, tt.wantDelete)
+			}
+		})
+	}
+}
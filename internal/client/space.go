@@ -0,0 +1,68 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+type spaceApiModel struct {
+	Name   string          `json:"name"`
+	Desc   string          `json:"desc,omitempty"`
+	Blocks []blockApiModel `json:"blocks"`
+	Size   int64           `json:"size,omitempty"`
+	Used   int64           `json:"used,omitempty"`
+}
+
+type blockApiModel struct {
+	Name      string                `json:"name"`
+	Cidr      string                `json:"cidr"`
+	Vnets     []blockVnetApiModel   `json:"vnets"`
+	Externals []externalApiModel    `json:"externals"`
+	Resv      []reservationApiModel `json:"resv"`
+	Size      int64                 `json:"size,omitempty"`
+	Used      int64                 `json:"used,omitempty"`
+}
+
+// blockVnetApiModel is a Virtual Network associated with a Block. Prefixes
+// are only returned when the Block is requested with expand=true.
+type blockVnetApiModel struct {
+	Id       string   `json:"id"`
+	Name     string   `json:"name,omitempty"`
+	Prefixes []string `json:"prefixes,omitempty"`
+	Active   bool     `json:"active,omitempty"`
+	Size     int64    `json:"size,omitempty"`
+	Used     int64    `json:"used,omitempty"`
+}
+
+type externalApiModel struct {
+	Name string `json:"name"`
+	Desc string `json:"desc,omitempty"`
+	Cidr string `json:"cidr"`
+}
+
+// spaceGet retrieves a Space with its Blocks, associated Virtual Networks and
+// reservations expanded.
+func (c *Client) spaceGet(ctx context.Context, space string) (spaceApiModel, error) {
+	var response spaceApiModel
+
+	url := fmt.Sprintf("%s/api/spaces/%s?expand=true", c.HostURL, strings.Trim(space, "\""))
+	if err := c.doJSON(ctx, "GET", url, nil, &response); err != nil {
+		return spaceApiModel{}, err
+	}
+
+	return response, nil
+}
+
+// blockGet retrieves a Block with its associated Virtual Networks expanded.
+func (c *Client) blockGet(ctx context.Context, space, block string) (blockApiModel, error) {
+	var response blockApiModel
+
+	url := fmt.Sprintf("%s/api/spaces/%s/blocks/%s?expand=true",
+		c.HostURL, strings.Trim(space, "\""), strings.Trim(block, "\""))
+	if err := c.doJSON(ctx, "GET", url, nil, &response); err != nil {
+		return blockApiModel{}, err
+	}
+
+	return response, nil
+}
@@ -0,0 +1,153 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"terraform-provider-azureipam/internal/gen/data_sources"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// The engine versions this client has been tested against. MinEngineVersion
+// is inclusive and MaxEngineVersion is exclusive.
+const (
+	MinEngineVersion = "3.0.0"
+	MaxEngineVersion = "4.0.0"
+)
+
+// The ways an unsupported engine version is reported, as set by the
+// check_engine_version provider attribute.
+const (
+	EngineVersionCheckOff   = "off"
+	EngineVersionCheckWarn  = "warn"
+	EngineVersionCheckError = "error"
+)
+
+// ParseEngineVersionCheck validates how an unsupported engine version is to
+// be reported. An empty value turns the check off.
+func ParseEngineVersionCheck(s string) (string, error) {
+	switch s {
+	case "":
+		return EngineVersionCheckOff, nil
+	case EngineVersionCheckOff, EngineVersionCheckWarn, EngineVersionCheckError:
+		return s, nil
+	}
+
+	return "", fmt.Errorf("%q is not one of %q, %q or %q", s, EngineVersionCheckOff, EngineVersionCheckWarn, EngineVersionCheckError)
+}
+
+type statusApiModel struct {
+	Status      string `json:"status"`
+	Version     string `json:"version"`
+	Stack       string `json:"stack,omitempty"`
+	Environment string `json:"environment,omitempty"`
+}
+
+// EngineStatusApiGet retrieves the status and version of the engine.
+func (c *Client) EngineStatusApiGet(ctx context.Context, data *data_sources.EngineStatusModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	response, err := c.statusGet(ctx)
+	if err != nil {
+		diags.AddError("API Request Error", fmt.Sprintf("API request failed: %s", err))
+		return diags
+	}
+
+	data.Status = types.StringValue(response.Status)
+	data.Version = types.StringValue(response.Version)
+	data.Stack = stringValueOrNull(response.Stack)
+	data.Environment = stringValueOrNull(response.Environment)
+	data.Compatible = types.BoolValue(checkEngineVersion(response.Version) == nil)
+
+	return diags
+}
+
+// CheckEngineVersion returns an error when the engine cannot be reached or its
+// version is outside of the range the client has been tested against.
+func (c *Client) CheckEngineVersion(ctx context.Context) error {
+	response, err := c.statusGet(ctx)
+	if err != nil {
+		return fmt.Errorf("could not retrieve the engine version: %w", err)
+	}
+
+	return checkEngineVersion(response.Version)
+}
+
+func (c *Client) statusGet(ctx context.Context) (statusApiModel, error) {
+	var response statusApiModel
+
+	if err := c.doJSON(ctx, "GET", fmt.Sprintf("%s/api/status", c.HostURL), nil, &response); err != nil {
+		return statusApiModel{}, err
+	}
+
+	return response, nil
+}
+
+func checkEngineVersion(version string) error {
+	v, err := parseVersion(version)
+	if err != nil {
+		return err
+	}
+
+	// The bounds are constants and always parse.
+	min, _ := parseVersion(MinEngineVersion)
+	max, _ := parseVersion(MaxEngineVersion)
+
+	if compareVersions(v, min) < 0 || compareVersions(v, max) >= 0 {
+		return fmt.Errorf("engine version %s is outside of the supported range >= %s, < %s", version, MinEngineVersion, MaxEngineVersion)
+	}
+
+	return nil
+}
+
+// parseVersion parses a major.minor.patch version, ignoring a leading "v" and
+// any pre-release or build suffix.
+func parseVersion(version string) ([3]int, error) {
+	var parsed [3]int
+
+	v := strings.TrimPrefix(strings.TrimSpace(version), "v")
+	if i := strings.IndexAny(v, "-+"); i >= 0 {
+		v = v[:i]
+	}
+
+	parts := strings.Split(v, ".")
+	if len(parts) > 3 {
+		return parsed, fmt.Errorf("invalid engine version %q", version)
+	}
+
+	for i, part := range parts {
+		n, err := strconv.Atoi(part)
+		if err != nil {
+			return parsed, fmt.Errorf("invalid engine version %q", version)
+		}
+		parsed[i] = n
+	}
+
+	return parsed, nil
+}
+
+func compareVersions(a, b [3]int) int {
+	for i := range a {
+		if a[i] != b[i] {
+			if a[i] < b[i] {
+				return -1
+			}
+			return 1
+		}
+	}
+
+	return 0
+}
+
+// stringValueOrNull maps the empty strings the engine returns for missing
+// values to null.
+func stringValueOrNull(s string) types.String {
+	if s == "" {
+		return types.StringNull()
+	}
+
+	return types.StringValue(s)
+}
@@ -0,0 +1,41 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-azureipam/internal/gen/data_sources"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type userApiModel struct {
+	Id         string `json:"id"`
+	Name       string `json:"name,omitempty"`
+	IsAdmin    bool   `json:"isAdmin"`
+	ApiRefresh int64  `json:"apiRefresh"`
+	DarkMode   bool   `json:"darkMode"`
+}
+
+// CurrentUserApiGet retrieves the caller, as identified by the engine from the
+// provider's token.
+func (c *Client) CurrentUserApiGet(ctx context.Context, data *data_sources.CurrentUserModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var response userApiModel
+	if err := c.doJSON(ctx, "GET", fmt.Sprintf("%s/api/users/me", c.HostURL), nil, &response); err != nil {
+		diags.AddError("API Request Error", fmt.Sprintf("API request failed: %s", err))
+		return diags
+	}
+
+	data.Id = types.StringValue(response.Id)
+	data.Name = types.StringNull()
+	if response.Name != "" {
+		data.Name = types.StringValue(response.Name)
+	}
+	data.IsAdmin = types.BoolValue(response.IsAdmin)
+	data.ApiRefresh = types.Int64Value(response.ApiRefresh)
+	data.DarkMode = types.BoolValue(response.DarkMode)
+
+	return diags
+}
@@ -12,6 +12,11 @@ import (
 func ReservationDataSourceSchema(ctx context.Context) schema.Schema {
 	return schema.Schema{
 		Attributes: map[string]schema.Attribute{
+			"address_count": schema.NumberAttribute{
+				Computed:            true,
+				Description:         "Number of addresses in the Reservation CIDR.",
+				MarkdownDescription: "Number of addresses in the Reservation CIDR.",
+			},
 			"block": schema.StringAttribute{
 				Required:            true,
 				Description:         "Name of the target Block",
@@ -29,9 +34,39 @@ func ReservationDataSourceSchema(ctx context.Context) schema.Schema {
 			"desc": schema.StringAttribute{
 				Computed: true,
 			},
+			"first_usable": schema.StringAttribute{
+				Computed:            true,
+				Description:         "First usable address of the Reservation CIDR. Excludes the network address, except for IPv4 /31 and /32 and IPv6 /127 and /128 CIDRs.",
+				MarkdownDescription: "First usable address of the Reservation CIDR. Excludes the network address, except for IPv4 /31 and /32 and IPv6 /127 and /128 CIDRs.",
+			},
 			"id": schema.StringAttribute{
 				Required: true,
 			},
+			"ip_version": schema.Int64Attribute{
+				Computed:            true,
+				Description:         "IP version of the Reservation, either 4 or 6.",
+				MarkdownDescription: "IP version of the Reservation, either 4 or 6.",
+			},
+			"last_usable": schema.StringAttribute{
+				Computed:            true,
+				Description:         "Last usable address of the Reservation CIDR. Excludes the IPv4 broadcast address, except for /31 and /32 CIDRs.",
+				MarkdownDescription: "Last usable address of the Reservation CIDR. Excludes the IPv4 broadcast address, except for /31 and /32 CIDRs.",
+			},
+			"netmask": schema.StringAttribute{
+				Computed:            true,
+				Description:         "Network mask of the Reservation CIDR, such as `255.255.252.0`.",
+				MarkdownDescription: "Network mask of the Reservation CIDR, such as `255.255.252.0`.",
+			},
+			"network_address": schema.StringAttribute{
+				Computed:            true,
+				Description:         "Network address of the Reservation CIDR.",
+				MarkdownDescription: "Network address of the Reservation CIDR.",
+			},
+			"prefix_length": schema.Int64Attribute{
+				Computed:            true,
+				Description:         "Prefix length of the Reservation CIDR.",
+				MarkdownDescription: "Prefix length of the Reservation CIDR.",
+			},
 			"settled_by": schema.StringAttribute{
 				Computed: true,
 			},
@@ -57,15 +92,22 @@ func ReservationDataSourceSchema(ctx context.Context) schema.Schema {
 }
 
 type ReservationModel struct {
-	Block     types.String `tfsdk:"block"`
-	Cidr      types.String `tfsdk:"cidr"`
-	CreatedBy types.String `tfsdk:"created_by"`
-	CreatedOn types.Number `tfsdk:"created_on"`
-	Desc      types.String `tfsdk:"desc"`
-	Id        types.String `tfsdk:"id"`
-	SettledBy types.String `tfsdk:"settled_by"`
-	SettledOn types.Number `tfsdk:"settled_on"`
-	Space     types.String `tfsdk:"space"`
-	Status    types.String `tfsdk:"status"`
-	Tag       types.Map    `tfsdk:"tag"`
+	AddressCount   types.Number `tfsdk:"address_count"`
+	Block          types.String `tfsdk:"block"`
+	Cidr           types.String `tfsdk:"cidr"`
+	CreatedBy      types.String `tfsdk:"created_by"`
+	CreatedOn      types.Number `tfsdk:"created_on"`
+	Desc           types.String `tfsdk:"desc"`
+	FirstUsable    types.String `tfsdk:"first_usable"`
+	Id             types.String `tfsdk:"id"`
+	IpVersion      types.Int64  `tfsdk:"ip_version"`
+	LastUsable     types.String `tfsdk:"last_usable"`
+	Netmask        types.String `tfsdk:"netmask"`
+	NetworkAddress types.String `tfsdk:"network_address"`
+	PrefixLength   types.Int64  `tfsdk:"prefix_length"`
+	SettledBy      types.String `tfsdk:"settled_by"`
+	SettledOn      types.Number `tfsdk:"settled_on"`
+	Space          types.String `tfsdk:"space"`
+	Status         types.String `tfsdk:"status"`
+	Tag            types.Map    `tfsdk:"tag"`
 }
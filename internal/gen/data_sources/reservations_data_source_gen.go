@@ -26,6 +26,11 @@ func ReservationsDataSourceSchema(ctx context.Context) schema.Schema {
 			"reservations": schema.SetNestedAttribute{
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
+						"address_count": schema.NumberAttribute{
+							Computed:            true,
+							Description:         "Number of addresses in the Reservation CIDR.",
+							MarkdownDescription: "Number of addresses in the Reservation CIDR.",
+						},
 						"block": schema.StringAttribute{
 							Computed: true,
 						},
@@ -41,9 +46,39 @@ func ReservationsDataSourceSchema(ctx context.Context) schema.Schema {
 						"desc": schema.StringAttribute{
 							Computed: true,
 						},
+						"first_usable": schema.StringAttribute{
+							Computed:            true,
+							Description:         "First usable address of the Reservation CIDR. Excludes the network address, except for IPv4 /31 and /32 and IPv6 /127 and /128 CIDRs.",
+							MarkdownDescription: "First usable address of the Reservation CIDR. Excludes the network address, except for IPv4 /31 and /32 and IPv6 /127 and /128 CIDRs.",
+						},
 						"id": schema.StringAttribute{
 							Computed: true,
 						},
+						"ip_version": schema.Int64Attribute{
+							Computed:            true,
+							Description:         "IP version of the Reservation, either 4 or 6.",
+							MarkdownDescription: "IP version of the Reservation, either 4 or 6.",
+						},
+						"last_usable": schema.StringAttribute{
+							Computed:            true,
+							Description:         "Last usable address of the Reservation CIDR. Excludes the IPv4 broadcast address, except for /31 and /32 CIDRs.",
+							MarkdownDescription: "Last usable address of the Reservation CIDR. Excludes the IPv4 broadcast address, except for /31 and /32 CIDRs.",
+						},
+						"netmask": schema.StringAttribute{
+							Computed:            true,
+							Description:         "Network mask of the Reservation CIDR, such as `255.255.252.0`.",
+							MarkdownDescription: "Network mask of the Reservation CIDR, such as `255.255.252.0`.",
+						},
+						"network_address": schema.StringAttribute{
+							Computed:            true,
+							Description:         "Network address of the Reservation CIDR.",
+							MarkdownDescription: "Network address of the Reservation CIDR.",
+						},
+						"prefix_length": schema.Int64Attribute{
+							Computed:            true,
+							Description:         "Prefix length of the Reservation CIDR.",
+							MarkdownDescription: "Prefix length of the Reservation CIDR.",
+						},
 						"settled_by": schema.StringAttribute{
 							Computed: true,
 						},
@@ -118,6 +153,24 @@ func (t ReservationsType) ValueFromObject(ctx context.Context, in basetypes.Obje
 
 	attributes := in.Attributes()
 
+	addressCountAttribute, ok := attributes["address_count"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`address_count is missing from object`)
+
+		return nil, diags
+	}
+
+	addressCountVal, ok := addressCountAttribute.(basetypes.NumberValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`address_count expected to be basetypes.NumberValue, was: %T`, addressCountAttribute))
+	}
+
 	blockAttribute, ok := attributes["block"]
 
 	if !ok {
@@ -208,6 +261,24 @@ func (t ReservationsType) ValueFromObject(ctx context.Context, in basetypes.Obje
 			fmt.Sprintf(`desc expected to be basetypes.StringValue, was: %T`, descAttribute))
 	}
 
+	firstUsableAttribute, ok := attributes["first_usable"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`first_usable is missing from object`)
+
+		return nil, diags
+	}
+
+	firstUsableVal, ok := firstUsableAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`first_usable expected to be basetypes.StringValue, was: %T`, firstUsableAttribute))
+	}
+
 	idAttribute, ok := attributes["id"]
 
 	if !ok {
@@ -226,6 +297,96 @@ func (t ReservationsType) ValueFromObject(ctx context.Context, in basetypes.Obje
 			fmt.Sprintf(`id expected to be basetypes.StringValue, was: %T`, idAttribute))
 	}
 
+	ipVersionAttribute, ok := attributes["ip_version"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`ip_version is missing from object`)
+
+		return nil, diags
+	}
+
+	ipVersionVal, ok := ipVersionAttribute.(basetypes.Int64Value)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`ip_version expected to be basetypes.Int64Value, was: %T`, ipVersionAttribute))
+	}
+
+	lastUsableAttribute, ok := attributes["last_usable"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`last_usable is missing from object`)
+
+		return nil, diags
+	}
+
+	lastUsableVal, ok := lastUsableAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`last_usable expected to be basetypes.StringValue, was: %T`, lastUsableAttribute))
+	}
+
+	netmaskAttribute, ok := attributes["netmask"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`netmask is missing from object`)
+
+		return nil, diags
+	}
+
+	netmaskVal, ok := netmaskAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`netmask expected to be basetypes.StringValue, was: %T`, netmaskAttribute))
+	}
+
+	networkAddressAttribute, ok := attributes["network_address"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`network_address is missing from object`)
+
+		return nil, diags
+	}
+
+	networkAddressVal, ok := networkAddressAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`network_address expected to be basetypes.StringValue, was: %T`, networkAddressAttribute))
+	}
+
+	prefixLengthAttribute, ok := attributes["prefix_length"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`prefix_length is missing from object`)
+
+		return nil, diags
+	}
+
+	prefixLengthVal, ok := prefixLengthAttribute.(basetypes.Int64Value)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`prefix_length expected to be basetypes.Int64Value, was: %T`, prefixLengthAttribute))
+	}
+
 	settledByAttribute, ok := attributes["settled_by"]
 
 	if !ok {
@@ -321,18 +482,25 @@ func (t ReservationsType) ValueFromObject(ctx context.Context, in basetypes.Obje
 	}
 
 	return ReservationsValue{
-		Block:     blockVal,
-		Cidr:      cidrVal,
-		CreatedBy: createdByVal,
-		CreatedOn: createdOnVal,
-		Desc:      descVal,
-		Id:        idVal,
-		SettledBy: settledByVal,
-		SettledOn: settledOnVal,
-		Space:     spaceVal,
-		Status:    statusVal,
-		Tag:       tagVal,
-		state:     attr.ValueStateKnown,
+		AddressCount:   addressCountVal,
+		Block:          blockVal,
+		Cidr:           cidrVal,
+		CreatedBy:      createdByVal,
+		CreatedOn:      createdOnVal,
+		Desc:           descVal,
+		FirstUsable:    firstUsableVal,
+		Id:             idVal,
+		IpVersion:      ipVersionVal,
+		LastUsable:     lastUsableVal,
+		Netmask:        netmaskVal,
+		NetworkAddress: networkAddressVal,
+		PrefixLength:   prefixLengthVal,
+		SettledBy:      settledByVal,
+		SettledOn:      settledOnVal,
+		Space:          spaceVal,
+		Status:         statusVal,
+		Tag:            tagVal,
+		state:          attr.ValueStateKnown,
 	}, diags
 }
 
@@ -399,6 +567,24 @@ func NewReservationsValue(attributeTypes map[string]attr.Type, attributes map[st
 		return NewReservationsValueUnknown(), diags
 	}
 
+	addressCountAttribute, ok := attributes["address_count"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`address_count is missing from object`)
+
+		return NewReservationsValueUnknown(), diags
+	}
+
+	addressCountVal, ok := addressCountAttribute.(basetypes.NumberValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`address_count expected to be basetypes.NumberValue, was: %T`, addressCountAttribute))
+	}
+
 	blockAttribute, ok := attributes["block"]
 
 	if !ok {
@@ -489,6 +675,24 @@ func NewReservationsValue(attributeTypes map[string]attr.Type, attributes map[st
 			fmt.Sprintf(`desc expected to be basetypes.StringValue, was: %T`, descAttribute))
 	}
 
+	firstUsableAttribute, ok := attributes["first_usable"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`first_usable is missing from object`)
+
+		return NewReservationsValueUnknown(), diags
+	}
+
+	firstUsableVal, ok := firstUsableAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`first_usable expected to be basetypes.StringValue, was: %T`, firstUsableAttribute))
+	}
+
 	idAttribute, ok := attributes["id"]
 
 	if !ok {
@@ -507,6 +711,96 @@ func NewReservationsValue(attributeTypes map[string]attr.Type, attributes map[st
 			fmt.Sprintf(`id expected to be basetypes.StringValue, was: %T`, idAttribute))
 	}
 
+	ipVersionAttribute, ok := attributes["ip_version"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`ip_version is missing from object`)
+
+		return NewReservationsValueUnknown(), diags
+	}
+
+	ipVersionVal, ok := ipVersionAttribute.(basetypes.Int64Value)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`ip_version expected to be basetypes.Int64Value, was: %T`, ipVersionAttribute))
+	}
+
+	lastUsableAttribute, ok := attributes["last_usable"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`last_usable is missing from object`)
+
+		return NewReservationsValueUnknown(), diags
+	}
+
+	lastUsableVal, ok := lastUsableAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`last_usable expected to be basetypes.StringValue, was: %T`, lastUsableAttribute))
+	}
+
+	netmaskAttribute, ok := attributes["netmask"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`netmask is missing from object`)
+
+		return NewReservationsValueUnknown(), diags
+	}
+
+	netmaskVal, ok := netmaskAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`netmask expected to be basetypes.StringValue, was: %T`, netmaskAttribute))
+	}
+
+	networkAddressAttribute, ok := attributes["network_address"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`network_address is missing from object`)
+
+		return NewReservationsValueUnknown(), diags
+	}
+
+	networkAddressVal, ok := networkAddressAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`network_address expected to be basetypes.StringValue, was: %T`, networkAddressAttribute))
+	}
+
+	prefixLengthAttribute, ok := attributes["prefix_length"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`prefix_length is missing from object`)
+
+		return NewReservationsValueUnknown(), diags
+	}
+
+	prefixLengthVal, ok := prefixLengthAttribute.(basetypes.Int64Value)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`prefix_length expected to be basetypes.Int64Value, was: %T`, prefixLengthAttribute))
+	}
+
 	settledByAttribute, ok := attributes["settled_by"]
 
 	if !ok {
@@ -602,18 +896,25 @@ func NewReservationsValue(attributeTypes map[string]attr.Type, attributes map[st
 	}
 
 	return ReservationsValue{
-		Block:     blockVal,
-		Cidr:      cidrVal,
-		CreatedBy: createdByVal,
-		CreatedOn: createdOnVal,
-		Desc:      descVal,
-		Id:        idVal,
-		SettledBy: settledByVal,
-		SettledOn: settledOnVal,
-		Space:     spaceVal,
-		Status:    statusVal,
-		Tag:       tagVal,
-		state:     attr.ValueStateKnown,
+		AddressCount:   addressCountVal,
+		Block:          blockVal,
+		Cidr:           cidrVal,
+		CreatedBy:      createdByVal,
+		CreatedOn:      createdOnVal,
+		Desc:           descVal,
+		FirstUsable:    firstUsableVal,
+		Id:             idVal,
+		IpVersion:      ipVersionVal,
+		LastUsable:     lastUsableVal,
+		Netmask:        netmaskVal,
+		NetworkAddress: networkAddressVal,
+		PrefixLength:   prefixLengthVal,
+		SettledBy:      settledByVal,
+		SettledOn:      settledOnVal,
+		Space:          spaceVal,
+		Status:         statusVal,
+		Tag:            tagVal,
+		state:          attr.ValueStateKnown,
 	}, diags
 }
 
@@ -685,32 +986,46 @@ func (t ReservationsType) ValueType(ctx context.Context) attr.Value {
 var _ basetypes.ObjectValuable = ReservationsValue{}
 
 type ReservationsValue struct {
-	Block     basetypes.StringValue `tfsdk:"block"`
-	Cidr      basetypes.StringValue `tfsdk:"cidr"`
-	CreatedBy basetypes.StringValue `tfsdk:"created_by"`
-	CreatedOn basetypes.NumberValue `tfsdk:"created_on"`
-	Desc      basetypes.StringValue `tfsdk:"desc"`
-	Id        basetypes.StringValue `tfsdk:"id"`
-	SettledBy basetypes.StringValue `tfsdk:"settled_by"`
-	SettledOn basetypes.NumberValue `tfsdk:"settled_on"`
-	Space     basetypes.StringValue `tfsdk:"space"`
-	Status    basetypes.StringValue `tfsdk:"status"`
-	Tag       basetypes.MapValue    `tfsdk:"tag"`
-	state     attr.ValueState
+	AddressCount   basetypes.NumberValue `tfsdk:"address_count"`
+	Block          basetypes.StringValue `tfsdk:"block"`
+	Cidr           basetypes.StringValue `tfsdk:"cidr"`
+	CreatedBy      basetypes.StringValue `tfsdk:"created_by"`
+	CreatedOn      basetypes.NumberValue `tfsdk:"created_on"`
+	Desc           basetypes.StringValue `tfsdk:"desc"`
+	FirstUsable    basetypes.StringValue `tfsdk:"first_usable"`
+	Id             basetypes.StringValue `tfsdk:"id"`
+	IpVersion      basetypes.Int64Value  `tfsdk:"ip_version"`
+	LastUsable     basetypes.StringValue `tfsdk:"last_usable"`
+	Netmask        basetypes.StringValue `tfsdk:"netmask"`
+	NetworkAddress basetypes.StringValue `tfsdk:"network_address"`
+	PrefixLength   basetypes.Int64Value  `tfsdk:"prefix_length"`
+	SettledBy      basetypes.StringValue `tfsdk:"settled_by"`
+	SettledOn      basetypes.NumberValue `tfsdk:"settled_on"`
+	Space          basetypes.StringValue `tfsdk:"space"`
+	Status         basetypes.StringValue `tfsdk:"status"`
+	Tag            basetypes.MapValue    `tfsdk:"tag"`
+	state          attr.ValueState
 }
 
 func (v ReservationsValue) ToTerraformValue(ctx context.Context) (tftypes.Value, error) {
-	attrTypes := make(map[string]tftypes.Type, 11)
+	attrTypes := make(map[string]tftypes.Type, 18)
 
 	var val tftypes.Value
 	var err error
 
+	attrTypes["address_count"] = basetypes.NumberType{}.TerraformType(ctx)
 	attrTypes["block"] = basetypes.StringType{}.TerraformType(ctx)
 	attrTypes["cidr"] = basetypes.StringType{}.TerraformType(ctx)
 	attrTypes["created_by"] = basetypes.StringType{}.TerraformType(ctx)
 	attrTypes["created_on"] = basetypes.NumberType{}.TerraformType(ctx)
 	attrTypes["desc"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["first_usable"] = basetypes.StringType{}.TerraformType(ctx)
 	attrTypes["id"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["ip_version"] = basetypes.Int64Type{}.TerraformType(ctx)
+	attrTypes["last_usable"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["netmask"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["network_address"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["prefix_length"] = basetypes.Int64Type{}.TerraformType(ctx)
 	attrTypes["settled_by"] = basetypes.StringType{}.TerraformType(ctx)
 	attrTypes["settled_on"] = basetypes.NumberType{}.TerraformType(ctx)
 	attrTypes["space"] = basetypes.StringType{}.TerraformType(ctx)
@@ -723,7 +1038,15 @@ func (v ReservationsValue) ToTerraformValue(ctx context.Context) (tftypes.Value,
 
 	switch v.state {
 	case attr.ValueStateKnown:
-		vals := make(map[string]tftypes.Value, 11)
+		vals := make(map[string]tftypes.Value, 18)
+
+		val, err = v.AddressCount.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["address_count"] = val
 
 		val, err = v.Block.ToTerraformValue(ctx)
 
@@ -765,6 +1088,14 @@ func (v ReservationsValue) ToTerraformValue(ctx context.Context) (tftypes.Value,
 
 		vals["desc"] = val
 
+		val, err = v.FirstUsable.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["first_usable"] = val
+
 		val, err = v.Id.ToTerraformValue(ctx)
 
 		if err != nil {
@@ -773,6 +1104,46 @@ func (v ReservationsValue) ToTerraformValue(ctx context.Context) (tftypes.Value,
 
 		vals["id"] = val
 
+		val, err = v.IpVersion.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["ip_version"] = val
+
+		val, err = v.LastUsable.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["last_usable"] = val
+
+		val, err = v.Netmask.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["netmask"] = val
+
+		val, err = v.NetworkAddress.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["network_address"] = val
+
+		val, err = v.PrefixLength.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["prefix_length"] = val
+
 		val, err = v.SettledBy.ToTerraformValue(ctx)
 
 		if err != nil {
@@ -848,16 +1219,23 @@ func (v ReservationsValue) ToObjectValue(ctx context.Context) (basetypes.ObjectV
 
 	if d.HasError() {
 		return types.ObjectUnknown(map[string]attr.Type{
-			"block":      basetypes.StringType{},
-			"cidr":       basetypes.StringType{},
-			"created_by": basetypes.StringType{},
-			"created_on": basetypes.NumberType{},
-			"desc":       basetypes.StringType{},
-			"id":         basetypes.StringType{},
-			"settled_by": basetypes.StringType{},
-			"settled_on": basetypes.NumberType{},
-			"space":      basetypes.StringType{},
-			"status":     basetypes.StringType{},
+			"address_count":   basetypes.NumberType{},
+			"block":           basetypes.StringType{},
+			"cidr":            basetypes.StringType{},
+			"created_by":      basetypes.StringType{},
+			"created_on":      basetypes.NumberType{},
+			"desc":            basetypes.StringType{},
+			"first_usable":    basetypes.StringType{},
+			"id":              basetypes.StringType{},
+			"ip_version":      basetypes.Int64Type{},
+			"last_usable":     basetypes.StringType{},
+			"netmask":         basetypes.StringType{},
+			"network_address": basetypes.StringType{},
+			"prefix_length":   basetypes.Int64Type{},
+			"settled_by":      basetypes.StringType{},
+			"settled_on":      basetypes.NumberType{},
+			"space":           basetypes.StringType{},
+			"status":          basetypes.StringType{},
 			"tag": basetypes.MapType{
 				ElemType: types.StringType,
 			},
@@ -865,16 +1243,23 @@ func (v ReservationsValue) ToObjectValue(ctx context.Context) (basetypes.ObjectV
 	}
 
 	attributeTypes := map[string]attr.Type{
-		"block":      basetypes.StringType{},
-		"cidr":       basetypes.StringType{},
-		"created_by": basetypes.StringType{},
-		"created_on": basetypes.NumberType{},
-		"desc":       basetypes.StringType{},
-		"id":         basetypes.StringType{},
-		"settled_by": basetypes.StringType{},
-		"settled_on": basetypes.NumberType{},
-		"space":      basetypes.StringType{},
-		"status":     basetypes.StringType{},
+		"address_count":   basetypes.NumberType{},
+		"block":           basetypes.StringType{},
+		"cidr":            basetypes.StringType{},
+		"created_by":      basetypes.StringType{},
+		"created_on":      basetypes.NumberType{},
+		"desc":            basetypes.StringType{},
+		"first_usable":    basetypes.StringType{},
+		"id":              basetypes.StringType{},
+		"ip_version":      basetypes.Int64Type{},
+		"last_usable":     basetypes.StringType{},
+		"netmask":         basetypes.StringType{},
+		"network_address": basetypes.StringType{},
+		"prefix_length":   basetypes.Int64Type{},
+		"settled_by":      basetypes.StringType{},
+		"settled_on":      basetypes.NumberType{},
+		"space":           basetypes.StringType{},
+		"status":          basetypes.StringType{},
 		"tag": basetypes.MapType{
 			ElemType: types.StringType,
 		},
@@ -891,17 +1276,24 @@ func (v ReservationsValue) ToObjectValue(ctx context.Context) (basetypes.ObjectV
 	objVal, diags := types.ObjectValue(
 		attributeTypes,
 		map[string]attr.Value{
-			"block":      v.Block,
-			"cidr":       v.Cidr,
-			"created_by": v.CreatedBy,
-			"created_on": v.CreatedOn,
-			"desc":       v.Desc,
-			"id":         v.Id,
-			"settled_by": v.SettledBy,
-			"settled_on": v.SettledOn,
-			"space":      v.Space,
-			"status":     v.Status,
-			"tag":        tagVal,
+			"address_count":   v.AddressCount,
+			"block":           v.Block,
+			"cidr":            v.Cidr,
+			"created_by":      v.CreatedBy,
+			"created_on":      v.CreatedOn,
+			"desc":            v.Desc,
+			"first_usable":    v.FirstUsable,
+			"id":              v.Id,
+			"ip_version":      v.IpVersion,
+			"last_usable":     v.LastUsable,
+			"netmask":         v.Netmask,
+			"network_address": v.NetworkAddress,
+			"prefix_length":   v.PrefixLength,
+			"settled_by":      v.SettledBy,
+			"settled_on":      v.SettledOn,
+			"space":           v.Space,
+			"status":          v.Status,
+			"tag":             tagVal,
 		})
 
 	return objVal, diags
@@ -922,6 +1314,10 @@ func (v ReservationsValue) Equal(o attr.Value) bool {
 		return true
 	}
 
+	if !v.AddressCount.Equal(other.AddressCount) {
+		return false
+	}
+
 	if !v.Block.Equal(other.Block) {
 		return false
 	}
@@ -942,10 +1338,34 @@ func (v ReservationsValue) Equal(o attr.Value) bool {
 		return false
 	}
 
+	if !v.FirstUsable.Equal(other.FirstUsable) {
+		return false
+	}
+
 	if !v.Id.Equal(other.Id) {
 		return false
 	}
 
+	if !v.IpVersion.Equal(other.IpVersion) {
+		return false
+	}
+
+	if !v.LastUsable.Equal(other.LastUsable) {
+		return false
+	}
+
+	if !v.Netmask.Equal(other.Netmask) {
+		return false
+	}
+
+	if !v.NetworkAddress.Equal(other.NetworkAddress) {
+		return false
+	}
+
+	if !v.PrefixLength.Equal(other.PrefixLength) {
+		return false
+	}
+
 	if !v.SettledBy.Equal(other.SettledBy) {
 		return false
 	}
@@ -979,16 +1399,23 @@ func (v ReservationsValue) Type(ctx context.Context) attr.Type {
 
 func (v ReservationsValue) AttributeTypes(ctx context.Context) map[string]attr.Type {
 	return map[string]attr.Type{
-		"block":      basetypes.StringType{},
-		"cidr":       basetypes.StringType{},
-		"created_by": basetypes.StringType{},
-		"created_on": basetypes.NumberType{},
-		"desc":       basetypes.StringType{},
-		"id":         basetypes.StringType{},
-		"settled_by": basetypes.StringType{},
-		"settled_on": basetypes.NumberType{},
-		"space":      basetypes.StringType{},
-		"status":     basetypes.StringType{},
+		"address_count":   basetypes.NumberType{},
+		"block":           basetypes.StringType{},
+		"cidr":            basetypes.StringType{},
+		"created_by":      basetypes.StringType{},
+		"created_on":      basetypes.NumberType{},
+		"desc":            basetypes.StringType{},
+		"first_usable":    basetypes.StringType{},
+		"id":              basetypes.StringType{},
+		"ip_version":      basetypes.Int64Type{},
+		"last_usable":     basetypes.StringType{},
+		"netmask":         basetypes.StringType{},
+		"network_address": basetypes.StringType{},
+		"prefix_length":   basetypes.Int64Type{},
+		"settled_by":      basetypes.StringType{},
+		"settled_on":      basetypes.NumberType{},
+		"space":           basetypes.StringType{},
+		"status":          basetypes.StringType{},
 		"tag": basetypes.MapType{
 			ElemType: types.StringType,
 		},
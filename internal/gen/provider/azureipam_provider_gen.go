@@ -4,7 +4,9 @@ package provider
 
 import (
 	"context"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-azureipam/internal/validators"
 
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 )
@@ -12,6 +14,14 @@ import (
 func AzureipamProviderSchema(ctx context.Context) schema.Schema {
 	return schema.Schema{
 		Attributes: map[string]schema.Attribute{
+			"check_engine_version": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Whether to check that the version of the Azure IPAM Engine is supported by the provider, and how to report an unsupported version. One of `off`, `warn` or `error`. If not specified, value will be attempted to be read from the `IPAM_CHECK_ENGINE_VERSION` environment variable, otherwise the version is not checked.",
+				MarkdownDescription: "Whether to check that the version of the Azure IPAM Engine is supported by the provider, and how to report an unsupported version. One of `off`, `warn` or `error`. If not specified, value will be attempted to be read from the `IPAM_CHECK_ENGINE_VERSION` environment variable, otherwise the version is not checked.",
+				Validators: []validator.String{
+					validators.StringOneOf("off", "warn", "error"),
+				},
+			},
 			"engine_client_id": schema.StringAttribute{
 				Optional:            true,
 				Sensitive:           true,
@@ -36,7 +46,8 @@ func AzureipamProviderSchema(ctx context.Context) schema.Schema {
 }
 
 type AzureipamModel struct {
-	EngineClientId types.String `tfsdk:"engine_client_id"`
-	HostUrl        types.String `tfsdk:"host_url"`
-	Token          types.String `tfsdk:"token"`
+	CheckEngineVersion types.String `tfsdk:"check_engine_version"`
+	EngineClientId     types.String `tfsdk:"engine_client_id"`
+	HostUrl            types.String `tfsdk:"host_url"`
+	Token              types.String `tfsdk:"token"`
 }
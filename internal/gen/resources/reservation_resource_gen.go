@@ -5,8 +5,17 @@ package resources
 import (
 	"context"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-azureipam/internal/planmodifiers"
+	"terraform-provider-azureipam/internal/validators"
 
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 )
@@ -14,16 +23,52 @@ import (
 func ReservationResourceSchema(ctx context.Context) schema.Schema {
 	return schema.Schema{
 		Attributes: map[string]schema.Attribute{
+			"address_count": schema.NumberAttribute{
+				Computed:            true,
+				Description:         "Number of addresses in the Reservation CIDR.",
+				MarkdownDescription: "Number of addresses in the Reservation CIDR.",
+			},
+			"allocated_block": schema.StringAttribute{
+				Computed:            true,
+				Description:         "Name of the Block the Reservation was allocated from.",
+				MarkdownDescription: "Name of the Block the Reservation was allocated from.",
+			},
 			"block": schema.StringAttribute{
-				Required:            true,
-				Description:         "Name of the target Block",
-				MarkdownDescription: "Name of the target Block",
+				Optional:            true,
+				Description:         "Name of the target Block. Conflicts with `blocks`.",
+				MarkdownDescription: "Name of the target Block. Conflicts with `blocks`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"block_selector": schema.StringAttribute{
+				Optional:            true,
+				Description:         "How Blocks from `blocks` are chosen. `ordered` tries them in the configured order, `most_free` tries the Block with the most free addresses first. Defaults to `ordered`.",
+				MarkdownDescription: "How Blocks from `blocks` are chosen. `ordered` tries them in the configured order, `most_free` tries the Block with the most free addresses first. Defaults to `ordered`.",
+				Validators: []validator.String{
+					validators.StringOneOf("ordered", "most_free"),
+				},
+			},
+			"blocks": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				Description:         "Names of the Blocks to try, in order, when the first cannot hold the Reservation. Conflicts with `block`.",
+				MarkdownDescription: "Names of the Blocks to try, in order, when the first cannot hold the Reservation. Conflicts with `block`.",
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
 			},
 			"cidr": schema.StringAttribute{
 				Optional:            true,
 				Computed:            true,
-				Description:         "CIDR of the Reservation.",
-				MarkdownDescription: "CIDR of the Reservation.",
+				Description:         "CIDR of the Reservation. Conflicts with `size`, `host_count` and `preferred_cidr`.",
+				MarkdownDescription: "CIDR of the Reservation. Conflicts with `size`, `host_count` and `preferred_cidr`.",
+				Validators: []validator.String{
+					validators.Cidr(),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+				},
 			},
 			"created_by": schema.StringAttribute{
 				Computed:            true,
@@ -40,19 +85,142 @@ func ReservationResourceSchema(ctx context.Context) schema.Schema {
 				Computed:            true,
 				Description:         "Description of the Reservation",
 				MarkdownDescription: "Description of the Reservation",
-				Default:             stringdefault.StaticString("New Reservation."),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Default: stringdefault.StaticString("New Reservation."),
+			},
+			"exclude_cidrs": schema.SetAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				Description:         "Never allocate the Reservation overlapping these CIDRs. Conflicts with `cidr`.",
+				MarkdownDescription: "Never allocate the Reservation overlapping these CIDRs. Conflicts with `cidr`.",
+				Validators: []validator.Set{
+					validators.SetEachString(validators.Cidr()),
+				},
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplace(),
+				},
+			},
+			"first_usable": schema.StringAttribute{
+				Computed:            true,
+				Description:         "First usable address of the Reservation CIDR. Excludes the network address, except for IPv4 /31 and /32 and IPv6 /127 and /128 CIDRs.",
+				MarkdownDescription: "First usable address of the Reservation CIDR. Excludes the network address, except for IPv4 /31 and /32 and IPv6 /127 and /128 CIDRs.",
+			},
+			"force_delete": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "Delete the Reservation on destroy even when it has already been settled by a Virtual Network, rather than only removing it from the state or failing. Defaults to `false`.",
+				MarkdownDescription: "Delete the Reservation on destroy even when it has already been settled by a Virtual Network, rather than only removing it from the state or failing. Defaults to `false`.",
+				Default:             booldefault.StaticBool(false),
+			},
+			"headroom_percent": schema.Int64Attribute{
+				Optional:            true,
+				Description:         "Growth headroom added to `host_count`, in percent.",
+				MarkdownDescription: "Growth headroom added to `host_count`, in percent.",
+				Validators: []validator.Int64{
+					validators.Int64AtLeast(0),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"host_count": schema.Int64Attribute{
+				Optional:            true,
+				Description:         "Number of hosts the Reservation must hold. The smallest fitting `size` is derived from it. Conflicts with `cidr`, `size` and `preferred_cidr`.",
+				MarkdownDescription: "Number of hosts the Reservation must hold. The smallest fitting `size` is derived from it. Conflicts with `cidr`, `size` and `preferred_cidr`.",
+				Validators: []validator.Int64{
+					validators.Int64AtLeast(1),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
 			},
 			"id": schema.StringAttribute{
 				Computed:            true,
 				Description:         "ID of the Reservation.",
 				MarkdownDescription: "ID of the Reservation.",
 			},
+			"idempotency_key": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "Key stamped in the description of the Reservation so that a Reservation created by a request whose response was lost is adopted rather than reserved twice. When set, an unsettled Reservation stamped with it, such as one left behind by an apply that stopped before the state was written, is adopted on create unless it is in the state. A random key is generated if not set, in which case only Reservations created during the same apply are adopted. Changing it replaces the Reservation, unless the Reservation was created before the attribute was added.",
+				MarkdownDescription: "Key stamped in the description of the Reservation so that a Reservation created by a request whose response was lost is adopted rather than reserved twice. When set, an unsettled Reservation stamped with it, such as one left behind by an apply that stopped before the state was written, is adopted on create unless it is in the state. A random key is generated if not set, in which case only Reservations created during the same apply are adopted. Changing it replaces the Reservation, unless the Reservation was created before the attribute was added.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					planmodifiers.StringRequiresReplaceIfStateSet(),
+				},
+			},
+			"include_azure_reserved": schema.BoolAttribute{
+				Optional:            true,
+				Description:         "Whether the five addresses Azure reserves in every Subnet are added to `host_count`.",
+				MarkdownDescription: "Whether the five addresses Azure reserves in every Subnet are added to `host_count`.",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"ip_version": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "IP version of the Reservation, either 4 or 6. Defaults to the IP version of `cidr` when set, otherwise to the IP version of the Block.",
+				MarkdownDescription: "IP version of the Reservation, either 4 or 6. Defaults to the IP version of `cidr` when set, otherwise to the IP version of the Block.",
+				Validators: []validator.Int64{
+					validators.Int64OneOf(4, 6),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplaceIfConfigured(),
+				},
+			},
+			"last_usable": schema.StringAttribute{
+				Computed:            true,
+				Description:         "Last usable address of the Reservation CIDR. Excludes the IPv4 broadcast address, except for /31 and /32 CIDRs.",
+				MarkdownDescription: "Last usable address of the Reservation CIDR. Excludes the IPv4 broadcast address, except for /31 and /32 CIDRs.",
+			},
+			"netmask": schema.StringAttribute{
+				Computed:            true,
+				Description:         "Network mask of the Reservation CIDR, such as `255.255.252.0`.",
+				MarkdownDescription: "Network mask of the Reservation CIDR, such as `255.255.252.0`.",
+			},
+			"network_address": schema.StringAttribute{
+				Computed:            true,
+				Description:         "Network address of the Reservation CIDR.",
+				MarkdownDescription: "Network address of the Reservation CIDR.",
+			},
+			"on_destroy": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "What happens to the Reservation when it is destroyed. `delete` deletes it unless it has already been settled by a Virtual Network, in which case it is only removed from the state, `abandon` only removes it from the state, and `fail_if_settled` fails the destroy when it has been settled. Defaults to `delete`.",
+				MarkdownDescription: "What happens to the Reservation when it is destroyed. `delete` deletes it unless it has already been settled by a Virtual Network, in which case it is only removed from the state, `abandon` only removes it from the state, and `fail_if_settled` fails the destroy when it has been settled. Defaults to `delete`.",
+				Validators: []validator.String{
+					validators.StringOneOf("delete", "abandon", "fail_if_settled"),
+				},
+				Default: stringdefault.StaticString("delete"),
+			},
+			"preferred_cidr": schema.StringAttribute{
+				Optional:            true,
+				Description:         "CIDR to reserve if it is free. Otherwise any free range of the same size is reserved, with a warning. Conflicts with `cidr`, `size` and `host_count`.",
+				MarkdownDescription: "CIDR to reserve if it is free. Otherwise any free range of the same size is reserved, with a warning. Conflicts with `cidr`, `size` and `host_count`.",
+				Validators: []validator.String{
+					validators.Cidr(),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"prefix_length": schema.Int64Attribute{
+				Computed:            true,
+				Description:         "Prefix length of the Reservation CIDR.",
+				MarkdownDescription: "Prefix length of the Reservation CIDR.",
+			},
 			"reverse_search": schema.BoolAttribute{
 				Optional:            true,
 				Computed:            true,
 				Description:         "Enable reverse search for the Reservation",
 				MarkdownDescription: "Enable reverse search for the Reservation",
-				Default:             booldefault.StaticBool(false),
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+				Default: booldefault.StaticBool(false),
 			},
 			"settled_by": schema.StringAttribute{
 				Computed:            true,
@@ -67,20 +235,32 @@ func ReservationResourceSchema(ctx context.Context) schema.Schema {
 			"size": schema.Int64Attribute{
 				Optional:            true,
 				Computed:            true,
-				Description:         "Size of the Reservation. Network mask bits.",
-				MarkdownDescription: "Size of the Reservation. Network mask bits.",
+				Description:         "Size of the Reservation. Network mask bits, between 8 and 32 for IPv4 and between 16 and 64 for IPv6. Derived from `host_count` or `preferred_cidr` when either is set. Conflicts with `cidr`, `host_count` and `preferred_cidr`.",
+				MarkdownDescription: "Size of the Reservation. Network mask bits, between 8 and 32 for IPv4 and between 16 and 64 for IPv6. Derived from `host_count` or `preferred_cidr` when either is set. Conflicts with `cidr`, `host_count` and `preferred_cidr`.",
+				Validators: []validator.Int64{
+					validators.Int64Between(8, 64),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplaceIfConfigured(),
+				},
 			},
 			"smallest_cidr": schema.BoolAttribute{
 				Optional:            true,
 				Computed:            true,
 				Description:         "Enable smallest CIDR for the Reservation",
 				MarkdownDescription: "Enable smallest CIDR for the Reservation",
-				Default:             booldefault.StaticBool(false),
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+				Default: booldefault.StaticBool(false),
 			},
 			"space": schema.StringAttribute{
 				Required:            true,
 				Description:         "Name of the target Space",
 				MarkdownDescription: "Name of the target Space",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			"status": schema.StringAttribute{
 				Computed:            true,
@@ -93,23 +273,53 @@ func ReservationResourceSchema(ctx context.Context) schema.Schema {
 				Description:         "Tags of the Reservation",
 				MarkdownDescription: "Tags of the Reservation",
 			},
+			"within_cidr": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Only allocate the Reservation within this CIDR of the Block. Conflicts with `cidr`.",
+				MarkdownDescription: "Only allocate the Reservation within this CIDR of the Block. Conflicts with `cidr`.",
+				Validators: []validator.String{
+					validators.Cidr(),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
 		},
 	}
 }
 
 type ReservationModel struct {
-	Block         types.String `tfsdk:"block"`
-	Cidr          types.String `tfsdk:"cidr"`
-	CreatedBy     types.String `tfsdk:"created_by"`
-	CreatedOn     types.Number `tfsdk:"created_on"`
-	Desc          types.String `tfsdk:"desc"`
-	Id            types.String `tfsdk:"id"`
-	ReverseSearch types.Bool   `tfsdk:"reverse_search"`
-	SettledBy     types.String `tfsdk:"settled_by"`
-	SettledOn     types.Number `tfsdk:"settled_on"`
-	Size          types.Int64  `tfsdk:"size"`
-	SmallestCidr  types.Bool   `tfsdk:"smallest_cidr"`
-	Space         types.String `tfsdk:"space"`
-	Status        types.String `tfsdk:"status"`
-	Tag           types.Map    `tfsdk:"tag"`
+	AddressCount         types.Number `tfsdk:"address_count"`
+	AllocatedBlock       types.String `tfsdk:"allocated_block"`
+	Block                types.String `tfsdk:"block"`
+	BlockSelector        types.String `tfsdk:"block_selector"`
+	Blocks               types.List   `tfsdk:"blocks"`
+	Cidr                 types.String `tfsdk:"cidr"`
+	CreatedBy            types.String `tfsdk:"created_by"`
+	CreatedOn            types.Number `tfsdk:"created_on"`
+	Desc                 types.String `tfsdk:"desc"`
+	ExcludeCidrs         types.Set    `tfsdk:"exclude_cidrs"`
+	FirstUsable          types.String `tfsdk:"first_usable"`
+	ForceDelete          types.Bool   `tfsdk:"force_delete"`
+	HeadroomPercent      types.Int64  `tfsdk:"headroom_percent"`
+	HostCount            types.Int64  `tfsdk:"host_count"`
+	Id                   types.String `tfsdk:"id"`
+	IdempotencyKey       types.String `tfsdk:"idempotency_key"`
+	IncludeAzureReserved types.Bool   `tfsdk:"include_azure_reserved"`
+	IpVersion            types.Int64  `tfsdk:"ip_version"`
+	LastUsable           types.String `tfsdk:"last_usable"`
+	Netmask              types.String `tfsdk:"netmask"`
+	NetworkAddress       types.String `tfsdk:"network_address"`
+	OnDestroy            types.String `tfsdk:"on_destroy"`
+	PreferredCidr        types.String `tfsdk:"preferred_cidr"`
+	PrefixLength         types.Int64  `tfsdk:"prefix_length"`
+	ReverseSearch        types.Bool   `tfsdk:"reverse_search"`
+	SettledBy            types.String `tfsdk:"settled_by"`
+	SettledOn            types.Number `tfsdk:"settled_on"`
+	Size                 types.Int64  `tfsdk:"size"`
+	SmallestCidr         types.Bool   `tfsdk:"smallest_cidr"`
+	Space                types.String `tfsdk:"space"`
+	Status               types.String `tfsdk:"status"`
+	Tag                  types.Map    `tfsdk:"tag"`
+	WithinCidr           types.String `tfsdk:"within_cidr"`
 }
@@ -0,0 +1,21 @@
+package planmodifiers
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+)
+
+// StringRequiresReplaceIfStateSet returns a plan modifier which requires
+// replacement when the value changes, unless the state holds no value, as in
+// the state of a resource created before the attribute was added.
+func StringRequiresReplaceIfStateSet() planmodifier.String {
+	return stringplanmodifier.RequiresReplaceIf(
+		func(_ context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+			resp.RequiresReplace = !req.StateValue.IsNull()
+		},
+		"If the value of this attribute changes, Terraform will destroy and recreate the resource, unless it has no value in the state.",
+		"If the value of this attribute changes, Terraform will destroy and recreate the resource, unless it has no value in the state.",
+	)
+}
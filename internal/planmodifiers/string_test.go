@@ -0,0 +1,44 @@
+package planmodifiers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestStringRequiresReplaceIfStateSet(t *testing.T) {
+	tests := []struct {
+		name  string
+		state types.String
+		plan  types.String
+		want  bool
+	}{
+		{name: "unchanged", state: types.StringValue("a"), plan: types.StringValue("a"), want: false},
+		{name: "changed", state: types.StringValue("a"), plan: types.StringValue("b"), want: true},
+		{name: "unknown", state: types.StringValue("a"), plan: types.StringUnknown(), want: true},
+		{name: "null state, unknown", state: types.StringNull(), plan: types.StringUnknown(), want: false},
+		{name: "null state, set", state: types.StringNull(), plan: types.StringValue("b"), want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := planmodifier.StringRequest{
+				State:      tfsdk.State{Raw: tftypes.NewValue(tftypes.Object{}, map[string]tftypes.Value{})},
+				Plan:       tfsdk.Plan{Raw: tftypes.NewValue(tftypes.Object{}, map[string]tftypes.Value{})},
+				StateValue: tt.state,
+				PlanValue:  tt.plan,
+			}
+			resp := &planmodifier.StringResponse{PlanValue: tt.plan}
+
+			StringRequiresReplaceIfStateSet().PlanModifyString(context.Background(), req, resp)
+
+			if resp.RequiresReplace != tt.want {
+				t.Errorf("RequiresReplace = %v, want %v", resp.RequiresReplace, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,152 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-azureipam/internal/client"
+	"terraform-provider-azureipam/internal/gen/resources"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+var (
+	_ resource.Resource                   = (*adminsResource)(nil)
+	_ resource.ResourceWithValidateConfig = (*adminsResource)(nil)
+)
+
+func NewAdminsResource() resource.Resource {
+	return &adminsResource{}
+}
+
+type adminsResource struct {
+	client *client.Client
+}
+
+func (r *adminsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_admins"
+}
+
+func (r *adminsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = resources.AdminsResourceSchema(ctx)
+}
+
+func (r *adminsResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data resources.AdminsModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Admins.IsUnknown() || data.AllowEmpty.IsUnknown() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateAdminsNotEmpty(data)...)
+}
+
+func (r *adminsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data resources.AdminsModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateAdminsNotEmpty(data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.client.AdminsApiPut(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *adminsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data resources.AdminsModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.client.AdminsResourceApiGet(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *adminsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data resources.AdminsModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateAdminsNotEmpty(data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.client.AdminsApiPut(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete only removes the admins from the Terraform state, as described in the
+// schema. Clearing the list of admins could lock everyone out of the engine.
+func (r *adminsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	resp.Diagnostics.AddWarning(
+		"Azure IPAM Admins Left Unchanged",
+		"The azureipam_admins resource was removed from the Terraform state, but the admins of the Azure IPAM engine were left unchanged.",
+	)
+}
+
+func (r *adminsResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Azure IPAM.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// validateAdminsNotEmpty refuses an empty list of admins unless allow_empty is
+// set.
+func validateAdminsNotEmpty(data resources.AdminsModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if len(data.Admins.Elements()) == 0 && !data.AllowEmpty.ValueBool() {
+		diags.AddAttributeError(
+			path.Root("admins"),
+			"Empty Admins List",
+			"Applying an empty list of admins could lock everyone out of the Azure IPAM engine. "+
+				"Set allow_empty to true if this is intended.",
+		)
+	}
+
+	return diags
+}
@@ -0,0 +1,77 @@
+package provider
+
+import (
+	"context"
+	"net/netip"
+	"terraform-provider-azureipam/internal/cidr"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = (*cidrContainsFunction)(nil)
+
+func NewCidrContainsFunction() function.Function {
+	return &cidrContainsFunction{}
+}
+
+type cidrContainsFunction struct{}
+
+func (f *cidrContainsFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "cidr_contains"
+}
+
+func (f *cidrContainsFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Check whether a CIDR contains an address or another CIDR",
+		Description: "Returns true when every address of `other` is part of `cidr`. `other` may be a single IP address or a CIDR.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "cidr",
+				Description: "The containing CIDR.",
+			},
+			function.StringParameter{
+				Name:        "other",
+				Description: "The IP address or CIDR to look for.",
+			},
+		},
+		Return: function.BoolReturn{},
+	}
+}
+
+func (f *cidrContainsFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var outer, other string
+
+	resp.Error = req.Arguments.Get(ctx, &outer, &other)
+	if resp.Error != nil {
+		return
+	}
+
+	outerPrefix, funcErr := parseCidrArgument(outer, 0)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	otherPrefix, funcErr := parseCidrArgument(other, 1)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, cidr.Contains(outerPrefix, otherPrefix))
+}
+
+// parseCidrArgument parses the function argument s as a CIDR. A single IP
+// address is treated as a CIDR covering only that address.
+func parseCidrArgument(s string, argument int64) (netip.Prefix, *function.FuncError) {
+	if addr, err := netip.ParseAddr(s); err == nil {
+		return netip.PrefixFrom(addr, addr.BitLen()), nil
+	}
+
+	prefix, err := cidr.Parse(s)
+	if err != nil {
+		return netip.Prefix{}, function.NewArgumentFuncError(argument, err.Error())
+	}
+
+	return prefix, nil
+}
@@ -0,0 +1,69 @@
+package provider
+
+import (
+	"context"
+	"terraform-provider-azureipam/internal/cidr"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = (*cidrHostRangeFunction)(nil)
+
+var cidrHostRangeAttributeTypes = map[string]attr.Type{
+	"first": types.StringType,
+	"last":  types.StringType,
+}
+
+func NewCidrHostRangeFunction() function.Function {
+	return &cidrHostRangeFunction{}
+}
+
+type cidrHostRangeFunction struct{}
+
+func (f *cidrHostRangeFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "cidr_host_range"
+}
+
+func (f *cidrHostRangeFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Get the first and last address of a CIDR",
+		Description: "Returns an object with the `first` and `last` addresses of `cidr`, including the network and broadcast addresses.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "cidr",
+				Description: "The CIDR.",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: cidrHostRangeAttributeTypes,
+		},
+	}
+}
+
+func (f *cidrHostRangeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var s string
+
+	resp.Error = req.Arguments.Get(ctx, &s)
+	if resp.Error != nil {
+		return
+	}
+
+	prefix, funcErr := parseCidrArgument(s, 0)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	result, diags := types.ObjectValue(cidrHostRangeAttributeTypes, map[string]attr.Value{
+		"first": types.StringValue(prefix.Addr().String()),
+		"last":  types.StringValue(cidr.Last(prefix).String()),
+	})
+	resp.Error = function.FuncErrorFromDiags(ctx, diags)
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, result)
+}
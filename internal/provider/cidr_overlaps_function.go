@@ -0,0 +1,60 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = (*cidrOverlapsFunction)(nil)
+
+func NewCidrOverlapsFunction() function.Function {
+	return &cidrOverlapsFunction{}
+}
+
+type cidrOverlapsFunction struct{}
+
+func (f *cidrOverlapsFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "cidr_overlaps"
+}
+
+func (f *cidrOverlapsFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Check whether two CIDRs overlap",
+		Description: "Returns true when `a` and `b` have at least one address in common. Either may be a single IP address.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "a",
+				Description: "The first IP address or CIDR.",
+			},
+			function.StringParameter{
+				Name:        "b",
+				Description: "The second IP address or CIDR.",
+			},
+		},
+		Return: function.BoolReturn{},
+	}
+}
+
+func (f *cidrOverlapsFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var a, b string
+
+	resp.Error = req.Arguments.Get(ctx, &a, &b)
+	if resp.Error != nil {
+		return
+	}
+
+	aPrefix, funcErr := parseCidrArgument(a, 0)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	bPrefix, funcErr := parseCidrArgument(b, 1)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, aPrefix.Overlaps(bPrefix))
+}
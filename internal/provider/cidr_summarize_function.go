@@ -0,0 +1,67 @@
+package provider
+
+import (
+	"context"
+	"net/netip"
+	"terraform-provider-azureipam/internal/cidr"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = (*cidrSummarizeFunction)(nil)
+
+func NewCidrSummarizeFunction() function.Function {
+	return &cidrSummarizeFunction{}
+}
+
+type cidrSummarizeFunction struct{}
+
+func (f *cidrSummarizeFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "cidr_summarize"
+}
+
+func (f *cidrSummarizeFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Summarize a list of CIDRs",
+		Description: "Returns the smallest list of CIDRs covering exactly the same addresses as `cidrs`. " +
+			"CIDRs contained in others are dropped and adjacent CIDRs are merged. The result is sorted by address, IPv4 first.",
+		Parameters: []function.Parameter{
+			function.ListParameter{
+				Name:        "cidrs",
+				Description: "The IP addresses and CIDRs to summarize.",
+				ElementType: types.StringType,
+			},
+		},
+		Return: function.ListReturn{
+			ElementType: types.StringType,
+		},
+	}
+}
+
+func (f *cidrSummarizeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var cidrs []string
+
+	resp.Error = req.Arguments.Get(ctx, &cidrs)
+	if resp.Error != nil {
+		return
+	}
+
+	prefixes := make([]netip.Prefix, len(cidrs))
+	for i, s := range cidrs {
+		prefix, funcErr := parseCidrArgument(s, 0)
+		if funcErr != nil {
+			resp.Error = funcErr
+			return
+		}
+		prefixes[i] = prefix
+	}
+
+	summary := cidr.Summarize(prefixes)
+	result := make([]string, len(summary))
+	for i, prefix := range summary {
+		result[i] = prefix.String()
+	}
+
+	resp.Error = resp.Result.Set(ctx, result)
+}
@@ -0,0 +1,62 @@
+package provider
+
+import (
+	"context"
+	"math/big"
+	"terraform-provider-azureipam/internal/cidr"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// azureReservedAddresses is the number of addresses Azure reserves in every
+// Subnet: the network address, the default gateway, two addresses for Azure
+// DNS and the broadcast address.
+const azureReservedAddresses = 5
+
+var _ function.Function = (*cidrUsableHostsAzureFunction)(nil)
+
+func NewCidrUsableHostsAzureFunction() function.Function {
+	return &cidrUsableHostsAzureFunction{}
+}
+
+type cidrUsableHostsAzureFunction struct{}
+
+func (f *cidrUsableHostsAzureFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "cidr_usable_hosts_azure"
+}
+
+func (f *cidrUsableHostsAzureFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Count the usable addresses of an Azure Subnet",
+		Description: "Returns the number of addresses of `cidr` that can be assigned to resources when it is used as an Azure Subnet, which reserves five addresses.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "cidr",
+				Description: "The CIDR of the Subnet.",
+			},
+		},
+		Return: function.NumberReturn{},
+	}
+}
+
+func (f *cidrUsableHostsAzureFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var s string
+
+	resp.Error = req.Arguments.Get(ctx, &s)
+	if resp.Error != nil {
+		return
+	}
+
+	prefix, funcErr := parseCidrArgument(s, 0)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	usable := new(big.Int).Sub(cidr.Size(prefix), big.NewInt(azureReservedAddresses))
+	if usable.Sign() < 0 {
+		usable.SetInt64(0)
+	}
+
+	resp.Error = resp.Result.Set(ctx, new(big.Float).SetInt(usable))
+}
@@ -7,13 +7,17 @@ import (
 	gen_provider "terraform-provider-azureipam/internal/gen/provider"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
-var _ provider.Provider = (*azureipamProvider)(nil)
+var (
+	_ provider.Provider              = (*azureipamProvider)(nil)
+	_ provider.ProviderWithFunctions = (*azureipamProvider)(nil)
+)
 
 func New(version string) func() provider.Provider {
 	return func() provider.Provider {
@@ -71,6 +75,7 @@ func (p *azureipamProvider) Configure(ctx context.Context, req provider.Configur
 	host := os.Getenv("IPAM_HOST_URL")
 	token := os.Getenv("IPAM_TOKEN")
 	clientID := os.Getenv("IPAM_ENGINE_CLIENT_ID")
+	checkEngineVersion := os.Getenv("IPAM_CHECK_ENGINE_VERSION")
 
 	if !config.HostUrl.IsNull() {
 		host = config.HostUrl.ValueString()
@@ -84,6 +89,10 @@ func (p *azureipamProvider) Configure(ctx context.Context, req provider.Configur
 		clientID = config.EngineClientId.ValueString()
 	}
 
+	if !config.CheckEngineVersion.IsNull() {
+		checkEngineVersion = config.CheckEngineVersion.ValueString()
+	}
+
 	// If any of the expected configurations are missing, return
 	// errors with provider-specific guidance.
 
@@ -96,10 +105,24 @@ func (p *azureipamProvider) Configure(ctx context.Context, req provider.Configur
 		)
 	}
 
+	versionCheck, err := client.ParseEngineVersionCheck(checkEngineVersion)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("check_engine_version"),
+			"Invalid Engine Version Check",
+			"The provider cannot create the Azure IPAM client as the engine version check is invalid: "+err.Error()+". "+
+				"Set the value statically in the configuration, or use the IPAM_CHECK_ENGINE_VERSION environment variable.",
+		)
+	}
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	// The client package is shadowed by the client below.
+	checkVersion := versionCheck != client.EngineVersionCheckOff
+	failOnVersion := versionCheck == client.EngineVersionCheckError
+
 	azToken, _ := client.GetAzureAccessToken(clientID)
 	if token == "" {
 		token = azToken
@@ -114,6 +137,18 @@ func (p *azureipamProvider) Configure(ctx context.Context, req provider.Configur
 		return
 	}
 
+	if checkVersion {
+		if err := client.CheckEngineVersion(ctx); err != nil {
+			summary := "Unsupported Azure IPAM Engine Version"
+			detail := "The Azure IPAM engine version could not be verified: " + err.Error()
+			if failOnVersion {
+				resp.Diagnostics.AddError(summary, detail)
+				return
+			}
+			resp.Diagnostics.AddWarning(summary, detail)
+		}
+	}
+
 	// Make the Azure IPAM client available during DataSource and Resource
 	// type Configure methods.
 	resp.DataSourceData = client
@@ -127,13 +162,39 @@ func (p *azureipamProvider) Metadata(ctx context.Context, req provider.MetadataR
 func (p *azureipamProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
 		NewAdminsDataSource,
+		NewAzureEndpointsDataSource,
+		NewAzureSubnetsDataSource,
+		NewAzureSubscriptionsDataSource,
+		NewAzureVnetsDataSource,
+		NewBlockUtilizationDataSource,
+		NewCurrentUserDataSource,
+		NewEngineStatusDataSource,
+		NewOverlapCheckDataSource,
+		NewReconciliationDataSource,
 		NewReservationDataSource,
 		NewReservationsDataSource,
+		NewSubnetPlanDataSource,
 	}
 }
 
 func (p *azureipamProvider) Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
+		NewAdminResource,
+		NewAdminsResource,
+		NewDualStackReservationResource,
+		NewExclusionResource,
+		NewExclusionsResource,
 		NewReservationResource,
 	}
 }
+
+func (p *azureipamProvider) Functions(ctx context.Context) []func() function.Function {
+	return []func() function.Function{
+		NewCidrContainsFunction,
+		NewCidrHostRangeFunction,
+		NewCidrOverlapsFunction,
+		NewCidrSummarizeFunction,
+		NewCidrUsableHostsAzureFunction,
+		NewSubnetPlanFunction,
+	}
+}
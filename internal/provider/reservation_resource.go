@@ -3,13 +3,33 @@ package provider
 import (
 	"context"
 	"fmt"
+	"math/big"
+	"net/netip"
+	"terraform-provider-azureipam/internal/cidr"
 	"terraform-provider-azureipam/internal/client"
 	"terraform-provider-azureipam/internal/gen/resources"
+	"terraform-provider-azureipam/internal/validators"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
-var _ resource.Resource = (*reservationResource)(nil)
+var (
+	_ resource.Resource                     = (*reservationResource)(nil)
+	_ resource.ResourceWithConfigValidators = (*reservationResource)(nil)
+	_ resource.ResourceWithModifyPlan       = (*reservationResource)(nil)
+	_ resource.ResourceWithValidateConfig   = (*reservationResource)(nil)
+)
+
+// reservationSizes are the network mask bits a Reservation may request for
+// each IP version.
+var reservationSizes = map[int64][2]int64{
+	4: {8, 32},
+	6: {16, 64},
+}
 
 func NewReservationResource() resource.Resource {
 	return &reservationResource{}
@@ -27,6 +47,191 @@ func (r *reservationResource) Schema(ctx context.Context, req resource.SchemaReq
 	resp.Schema = resources.ReservationResourceSchema(ctx)
 }
 
+func (r *reservationResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		validators.ExactlyOneOf(
+			path.Root("cidr"),
+			path.Root("size"),
+			path.Root("host_count"),
+			path.Root("preferred_cidr"),
+		),
+		validators.ExactlyOneOf(
+			path.Root("block"),
+			path.Root("blocks"),
+		),
+	}
+}
+
+func (r *reservationResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data resources.ReservationModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateReservationVersion(data.IpVersion, data.Cidr, data.Size, path.Root("cidr"), path.Root("size"))...)
+
+	// The prefix length of a preferred CIDR is the size to fall back to.
+	if !data.PreferredCidr.IsNull() && !data.PreferredCidr.IsUnknown() {
+		if prefix, err := netip.ParsePrefix(data.PreferredCidr.ValueString()); err == nil {
+			resp.Diagnostics.Append(validateReservationVersion(data.IpVersion, data.PreferredCidr, types.Int64Value(int64(prefix.Bits())), path.Root("preferred_cidr"), path.Root("preferred_cidr"))...)
+			resp.Diagnostics.Append(validatePreferredConstraints(ctx, prefix, data.WithinCidr, data.ExcludeCidrs)...)
+		}
+	}
+
+	if !data.Blocks.IsNull() && !data.Blocks.IsUnknown() && len(data.Blocks.Elements()) == 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("blocks"),
+			"Invalid Attribute Value",
+			"blocks must contain at least one Block.",
+		)
+	}
+
+	if data.Blocks.IsNull() && !data.BlockSelector.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("block_selector"),
+			"Invalid Attribute Combination",
+			"block_selector can only be set together with blocks.",
+		)
+	}
+
+	if !data.Cidr.IsNull() && (!data.WithinCidr.IsNull() || !data.ExcludeCidrs.IsNull()) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("cidr"),
+			"Invalid Attribute Combination",
+			"within_cidr and exclude_cidrs cannot be set together with cidr.",
+		)
+	}
+
+	if data.HostCount.IsNull() {
+		if !data.HeadroomPercent.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("headroom_percent"),
+				"Invalid Attribute Combination",
+				"headroom_percent can only be set together with host_count.",
+			)
+		}
+
+		if !data.IncludeAzureReserved.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("include_azure_reserved"),
+				"Invalid Attribute Combination",
+				"include_azure_reserved can only be set together with host_count.",
+			)
+		}
+	}
+
+	if data.OnDestroy.ValueString() == client.OnDestroyAbandon && data.ForceDelete.ValueBool() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("force_delete"),
+			"Invalid Attribute Combination",
+			"force_delete cannot be set when on_destroy is abandon.",
+		)
+	}
+}
+
+// ModifyPlan checks that a planned Reservation fits in its Block, so that an
+// exhausted Block is reported by the plan rather than halfway through an
+// apply. Only new Reservations are checked. A change to attributes the engine
+// does not store, such as on_destroy, keeps the rest of the state as it is.
+func (r *reservationResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	if !req.State.Raw.IsNull() {
+		if !stateOnlyUpdate(req.Config.Raw, req.Plan.Raw, req.State.Raw) {
+			// The replacement is planned next, with its prefix free.
+			if len(resp.RequiresReplace) > 0 && r.client != nil {
+				var state resources.ReservationModel
+
+				resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+
+				r.client.ReservationReplaced(&state)
+			}
+			return
+		}
+
+		var plan, state resources.ReservationModel
+
+		resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		applyStateOnly(&state, &plan)
+
+		resp.Diagnostics.Append(resp.Plan.Set(ctx, &state)...)
+		return
+	}
+
+	if r.client == nil {
+		return
+	}
+
+	var data resources.ReservationModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Space.IsUnknown() || data.Block.IsUnknown() || data.Blocks.IsUnknown() || data.WithinCidr.IsUnknown() || data.ExcludeCidrs.IsUnknown() {
+		return
+	}
+
+	// The size derived from host_count is shown in the plan.
+	if !data.HostCount.IsNull() {
+		if data.HostCount.IsUnknown() || data.HeadroomPercent.IsUnknown() || data.IncludeAzureReserved.IsUnknown() {
+			return
+		}
+
+		resp.Diagnostics.Append(r.hostCountSize(ctx, &data)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("size"), data.Size)...)
+	}
+
+	// Without ip_version or cidr, ValidateConfig cannot tell which sizes are
+	// allowed, so size is checked against the IP version of the Block. Both
+	// are computed, so the config tells whether they were set.
+	var configCidr types.String
+	var configIpVersion types.Int64
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("cidr"), &configCidr)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("ip_version"), &configIpVersion)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.HostCount.IsNull() && configCidr.IsNull() && configIpVersion.IsNull() && !data.Size.IsNull() && !data.Size.IsUnknown() {
+		blocks, diags := client.ReservationBlockNames(ctx, &data)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		version, diags := r.client.BlockIpVersion(ctx, data.Space.ValueString(), blocks[0])
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		resp.Diagnostics.Append(validateReservationVersion(types.Int64Value(version), data.Cidr, data.Size, path.Root("cidr"), path.Root("size"))...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(r.client.ReservationCapacityCheck(ctx, &data)...)
+}
+
 func (r *reservationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data resources.ReservationModel
 
@@ -35,6 +240,11 @@ func (r *reservationResource) Create(ctx context.Context, req resource.CreateReq
 		return
 	}
 
+	resp.Diagnostics.Append(r.hostCountSize(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	resp.Diagnostics.Append(r.client.ReservationApiPost(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -51,28 +261,39 @@ func (r *reservationResource) Read(ctx context.Context, req resource.ReadRequest
 		return
 	}
 
-	resp.Diagnostics.Append(r.client.ReservationApiGetDelete(ctx, &data, "GET")...)
+	found, diags := r.client.ReservationApiGetDelete(ctx, &data, "GET")
+	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	if !found {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
+// Update only records stateOnlyAttributes, as every attribute sent to the
+// engine requires replacement. The only exception is an idempotency_key set
+// on a Reservation created before the attribute was added, which is recorded
+// as it is.
 func (r *reservationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var data resources.ReservationModel
+	var plan, state resources.ReservationModel
 
-	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	resp.Diagnostics.Append(r.client.ReservationApiPost(ctx, &data)...)
-	if resp.Diagnostics.HasError() {
-		return
+	applyStateOnly(&state, &plan)
+	if state.IdempotencyKey.IsNull() && !plan.IdempotencyKey.IsUnknown() {
+		state.IdempotencyKey = plan.IdempotencyKey
 	}
 
-	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
 func (r *reservationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -83,10 +304,7 @@ func (r *reservationResource) Delete(ctx context.Context, req resource.DeleteReq
 		return
 	}
 
-	resp.Diagnostics.Append(r.client.ReservationApiGetDelete(ctx, &data, "DELETE")...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
+	resp.Diagnostics.Append(r.client.ReservationApiDestroy(ctx, &data)...)
 }
 func (r *reservationResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	// Add a nil check when handling ProviderData because Terraform
@@ -107,3 +325,189 @@ func (r *reservationResource) Configure(_ context.Context, req resource.Configur
 
 	r.client = client
 }
+
+// hostCountSize sets the size of a Reservation requested by host_count to the
+// smallest size holding the hosts, the growth headroom and, if requested, the
+// addresses Azure reserves in every Subnet. A size already known, such as the
+// one shown in the plan, is kept.
+func (r *reservationResource) hostCountSize(ctx context.Context, data *resources.ReservationModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if data.HostCount.IsNull() || !data.Size.IsUnknown() {
+		return diags
+	}
+
+	// Every Block a Reservation may be allocated from is expected to be of
+	// the same IP version, so the first one is representative.
+	version := data.IpVersion.ValueInt64()
+	if data.IpVersion.IsNull() || data.IpVersion.IsUnknown() {
+		blocks, blockDiags := client.ReservationBlockNames(ctx, data)
+		diags.Append(blockDiags...)
+		if diags.HasError() {
+			return diags
+		}
+
+		blockVersion, versionDiags := r.client.BlockIpVersion(ctx, data.Space.ValueString(), blocks[0])
+		diags.Append(versionDiags...)
+		if diags.HasError() {
+			return diags
+		}
+		version = blockVersion
+	}
+
+	// Round the headroom up so that it never shrinks the Reservation.
+	count := big.NewInt(data.HostCount.ValueInt64())
+	count.Mul(count, big.NewInt(100+data.HeadroomPercent.ValueInt64()))
+	count.Add(count, big.NewInt(99))
+	count.Div(count, big.NewInt(100))
+
+	if data.IncludeAzureReserved.ValueBool() {
+		count.Add(count, big.NewInt(azureReservedAddresses))
+	}
+
+	bitLen := 32
+	if version == 6 {
+		bitLen = 128
+	}
+
+	sizes := reservationSizes[version]
+	size := int64(cidr.LengthFor(count, bitLen))
+	if size > sizes[1] {
+		size = sizes[1]
+	}
+
+	if size < sizes[0] {
+		diags.AddAttributeError(
+			path.Root("host_count"),
+			"Invalid Attribute Value",
+			fmt.Sprintf("%s addresses do not fit in the largest IPv%d Reservation, of size %d.", count, version, sizes[0]),
+		)
+		return diags
+	}
+
+	data.Size = types.Int64Value(size)
+
+	return diags
+}
+
+// stateOnlyAttributes are the attributes of a Reservation which only affect
+// the provider. They are the only ones that change in place, every other
+// attribute requires replacement.
+var stateOnlyAttributes = map[string]bool{
+	"block_selector": true,
+	"force_delete":   true,
+	"on_destroy":     true,
+}
+
+// applyStateOnly copies the stateOnlyAttributes of plan to state.
+func applyStateOnly(state, plan *resources.ReservationModel) {
+	state.OnDestroy = plan.OnDestroy
+	state.ForceDelete = plan.ForceDelete
+	state.BlockSelector = plan.BlockSelector
+}
+
+// stateOnlyUpdate reports whether a planned update of a Reservation only
+// changes stateOnlyAttributes. Computed attributes that are not configured and
+// not known yet are ignored, as they would keep their value.
+func stateOnlyUpdate(config, plan, state tftypes.Value) bool {
+	var configAttrs, planAttrs, stateAttrs map[string]tftypes.Value
+	if config.As(&configAttrs) != nil || plan.As(&planAttrs) != nil || state.As(&stateAttrs) != nil {
+		return false
+	}
+
+	for name, value := range planAttrs {
+		if stateOnlyAttributes[name] {
+			continue
+		}
+
+		if !value.IsKnown() && configAttrs[name].IsNull() {
+			continue
+		}
+
+		if !value.Equal(stateAttrs[name]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// validatePreferredConstraints ensures a preferred CIDR is within within_cidr
+// and does not overlap exclude_cidrs, as it could never be reserved
+// otherwise. Null or unknown values are left to be validated once known.
+func validatePreferredConstraints(ctx context.Context, preferred netip.Prefix, within types.String, exclude types.Set) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if !within.IsNull() && !within.IsUnknown() {
+		if withinPrefix, err := netip.ParsePrefix(within.ValueString()); err == nil && !cidr.Contains(withinPrefix, preferred) {
+			diags.AddAttributeError(
+				path.Root("preferred_cidr"),
+				"Invalid Attribute Combination",
+				fmt.Sprintf("%s is not within within_cidr %s.", preferred, within.ValueString()),
+			)
+		}
+	}
+
+	if exclude.IsNull() || exclude.IsUnknown() {
+		return diags
+	}
+
+	var excluded []types.String
+	diags.Append(exclude.ElementsAs(ctx, &excluded, false)...)
+	for _, v := range excluded {
+		if v.IsNull() || v.IsUnknown() {
+			continue
+		}
+
+		if excludedPrefix, err := netip.ParsePrefix(v.ValueString()); err == nil && excludedPrefix.Overlaps(preferred) {
+			diags.AddAttributeError(
+				path.Root("preferred_cidr"),
+				"Invalid Attribute Combination",
+				fmt.Sprintf("%s overlaps %s of exclude_cidrs.", preferred, v.ValueString()),
+			)
+		}
+	}
+
+	return diags
+}
+
+// validateReservationVersion ensures a Reservation's CIDR and size agree with
+// its IP version. Null or unknown values are left to be validated once known.
+func validateReservationVersion(ipVersion types.Int64, reservationCidr types.String, size types.Int64, cidrPath, sizePath path.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	version := ipVersion.ValueInt64()
+
+	if !reservationCidr.IsNull() && !reservationCidr.IsUnknown() {
+		prefix, err := netip.ParsePrefix(reservationCidr.ValueString())
+		if err != nil {
+			// Reported by the attribute validator.
+			return diags
+		}
+
+		if !ipVersion.IsNull() && !ipVersion.IsUnknown() && cidr.Version(prefix) != version {
+			diags.AddAttributeError(
+				cidrPath,
+				"IP Version Mismatch",
+				fmt.Sprintf("%s is not an IPv%d CIDR.", reservationCidr.ValueString(), version),
+			)
+		}
+
+		version = cidr.Version(prefix)
+	}
+
+	sizes, ok := reservationSizes[version]
+	if !ok || size.IsNull() || size.IsUnknown() {
+		return diags
+	}
+
+	if size.ValueInt64() < sizes[0] || size.ValueInt64() > sizes[1] {
+		diags.AddAttributeError(
+			sizePath,
+			"Invalid Attribute Value",
+			fmt.Sprintf("IPv%d Reservations must have a size between %d and %d, got: %d.", version, sizes[0], sizes[1], size.ValueInt64()),
+		)
+	}
+
+	return diags
+}
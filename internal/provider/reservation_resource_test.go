@@ -0,0 +1,322 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"terraform-provider-azureipam/internal/client"
+	"terraform-provider-azureipam/internal/gen/resources"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// reservationType returns the Terraform type of a Reservation.
+func reservationType(t *testing.T) tftypes.Object {
+	t.Helper()
+
+	objectType, ok := resources.ReservationResourceSchema(context.Background()).Type().TerraformType(context.Background()).(tftypes.Object)
+	if !ok {
+		t.Fatal("the Reservation schema is not an object")
+	}
+
+	return objectType
+}
+
+// reservationValue builds a Reservation object with every attribute null
+// except those in attrs.
+func reservationValue(t *testing.T, attrs map[string]tftypes.Value) tftypes.Value {
+	t.Helper()
+
+	objectType := reservationType(t)
+
+	values := map[string]tftypes.Value{}
+	for name, attrType := range objectType.AttributeTypes {
+		values[name] = tftypes.NewValue(attrType, nil)
+	}
+	for name, value := range attrs {
+		values[name] = value
+	}
+
+	return tftypes.NewValue(objectType, values)
+}
+
+func TestStateOnlyUpdate(t *testing.T) {
+	str := func(v string) tftypes.Value { return tftypes.NewValue(tftypes.String, v) }
+	num := func(v int64) tftypes.Value { return tftypes.NewValue(tftypes.Number, big.NewFloat(float64(v))) }
+	unknownStr := tftypes.NewValue(tftypes.String, tftypes.UnknownValue)
+	unknownNum := tftypes.NewValue(tftypes.Number, tftypes.UnknownValue)
+
+	state := map[string]tftypes.Value{
+		"space":           str("s"),
+		"block":           str("b"),
+		"size":            num(24),
+		"cidr":            str("10.0.0.0/24"),
+		"id":              str("abc"),
+		"status":          str("wait"),
+		"on_destroy":      str("delete"),
+		"ip_version":      num(4),
+		"network_address": str("10.0.0.0"),
+	}
+
+	tests := []struct {
+		name   string
+		config map[string]tftypes.Value
+		plan   map[string]tftypes.Value
+		want   bool
+	}{
+		{
+			name:   "no change",
+			config: map[string]tftypes.Value{"space": str("s"), "block": str("b"), "size": num(24)},
+			plan:   map[string]tftypes.Value{},
+			want:   true,
+		},
+		{
+			name:   "on_destroy changed",
+			config: map[string]tftypes.Value{"space": str("s"), "block": str("b"), "size": num(24), "on_destroy": str("abandon")},
+			plan:   map[string]tftypes.Value{"on_destroy": str("abandon")},
+			want:   true,
+		},
+		{
+			name:   "force_delete and block_selector changed",
+			config: map[string]tftypes.Value{"space": str("s"), "block": str("b"), "size": num(24)},
+			plan:   map[string]tftypes.Value{"force_delete": tftypes.NewValue(tftypes.Bool, true), "block_selector": str("most_free")},
+			want:   true,
+		},
+		{
+			name:   "unknown computed values",
+			config: map[string]tftypes.Value{"space": str("s"), "block": str("b"), "size": num(24), "on_destroy": str("abandon")},
+			plan: map[string]tftypes.Value{
+				"on_destroy":      str("abandon"),
+				"cidr":            unknownStr,
+				"id":              unknownStr,
+				"status":          unknownStr,
+				"ip_version":      unknownNum,
+				"network_address": unknownStr,
+			},
+			want: true,
+		},
+		{
+			name:   "configured value unknown",
+			config: map[string]tftypes.Value{"space": str("s"), "block": str("b"), "size": unknownNum},
+			plan:   map[string]tftypes.Value{"size": unknownNum},
+			want:   false,
+		},
+		{
+			name:   "configured value changed",
+			config: map[string]tftypes.Value{"space": str("s"), "block": str("b"), "size": num(25), "on_destroy": str("abandon")},
+			plan:   map[string]tftypes.Value{"size": num(25), "on_destroy": str("abandon"), "cidr": unknownStr},
+			want:   false,
+		},
+		{
+			name:   "desc changed",
+			config: map[string]tftypes.Value{"space": str("s"), "block": str("b"), "size": num(24), "desc": str("other")},
+			plan:   map[string]tftypes.Value{"desc": str("other")},
+			want:   false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			plan := map[string]tftypes.Value{}
+			for name, value := range state {
+				plan[name] = value
+			}
+			for name, value := range tt.plan {
+				plan[name] = value
+			}
+
+			got := stateOnlyUpdate(reservationValue(t, tt.config), reservationValue(t, plan), reservationValue(t, state))
+			if got != tt.want {
+				t.Errorf("stateOnlyUpdate() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestModifyPlanBlockVersion(t *testing.T) {
+	str := func(v string) tftypes.Value { return tftypes.NewValue(tftypes.String, v) }
+	num := func(v int64) tftypes.Value { return tftypes.NewValue(tftypes.Number, big.NewFloat(float64(v))) }
+	unknownStr := tftypes.NewValue(tftypes.String, tftypes.UnknownValue)
+	unknownNum := tftypes.NewValue(tftypes.Number, tftypes.UnknownValue)
+
+	tests := []struct {
+		name      string
+		blockCidr string
+		config    map[string]tftypes.Value
+		wantErr   string
+	}{
+		{
+			name:      "IPv4 size in range",
+			blockCidr: "10.0.0.0/16",
+			config:    map[string]tftypes.Value{"size": num(24)},
+		},
+		{
+			name:      "IPv6 size out of range for IPv4 Block",
+			blockCidr: "10.0.0.0/16",
+			config:    map[string]tftypes.Value{"size": num(40)},
+			wantErr:   "Invalid Attribute Value",
+		},
+		{
+			name:      "IPv6 size in range",
+			blockCidr: "fd00::/32",
+			config:    map[string]tftypes.Value{"size": num(40)},
+		},
+		{
+			name:      "IPv4 size out of range for IPv6 Block",
+			blockCidr: "fd00::/32",
+			config:    map[string]tftypes.Value{"size": num(8)},
+			wantErr:   "Invalid Attribute Value",
+		},
+		{
+			name:      "configured ip_version is left to ValidateConfig",
+			blockCidr: "10.0.0.0/16",
+			config:    map[string]tftypes.Value{"size": num(24), "ip_version": num(4)},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				fmt.Fprintf(w, `{"name":"b","cidr":%q,"vnets":[],"externals":[],"resv":[]}`, tt.blockCidr)
+			}))
+			defer server.Close()
+
+			config := map[string]tftypes.Value{"space": str("s"), "block": str("b")}
+			for name, value := range tt.config {
+				config[name] = value
+			}
+
+			plan := map[string]tftypes.Value{"cidr": unknownStr, "id": unknownStr, "ip_version": unknownNum}
+			for name, value := range config {
+				plan[name] = value
+			}
+
+			schema := resources.ReservationResourceSchema(context.Background())
+			req := resource.ModifyPlanRequest{
+				Config: tfsdk.Config{Schema: schema, Raw: reservationValue(t, config)},
+				Plan:   tfsdk.Plan{Schema: schema, Raw: reservationValue(t, plan)},
+				State:  tfsdk.State{Schema: schema, Raw: tftypes.NewValue(reservationType(t), nil)},
+			}
+			resp := &resource.ModifyPlanResponse{Plan: req.Plan}
+
+			r := &reservationResource{client: &client.Client{HostURL: server.URL, HTTPClient: server.Client()}}
+			r.ModifyPlan(context.Background(), req, resp)
+
+			var gotErr string
+			if errs := resp.Diagnostics.Errors(); len(errs) > 0 {
+				gotErr = errs[0].Summary()
+			}
+			if gotErr != tt.wantErr {
+				t.Errorf("ModifyPlan() error = %q, want %q (diagnostics: %v)", gotErr, tt.wantErr, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+// A Reservation created before idempotency_key, on_destroy and force_delete
+// were added has them null in its state, and must not be replaced.
+func TestPlanBaselineState(t *testing.T) {
+	str := func(v string) tftypes.Value { return tftypes.NewValue(tftypes.String, v) }
+	num := func(v int64) tftypes.Value { return tftypes.NewValue(tftypes.Number, big.NewFloat(float64(v))) }
+
+	config := map[string]tftypes.Value{"space": str("s"), "block": str("b"), "size": num(24)}
+
+	// The attributes of the baseline schema.
+	state := map[string]tftypes.Value{
+		"id":             str("abc"),
+		"cidr":           str("10.0.0.0/24"),
+		"created_by":     str("user"),
+		"created_on":     num(1700000000),
+		"desc":           str("New Reservation."),
+		"reverse_search": tftypes.NewValue(tftypes.Bool, false),
+		"settled_by":     str(""),
+		"settled_on":     num(0),
+		"smallest_cidr":  tftypes.NewValue(tftypes.Bool, false),
+		"status":         str("wait"),
+		"tag":            tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+	}
+	for name, value := range config {
+		state[name] = value
+	}
+
+	tests := []struct {
+		name    string
+		config  map[string]tftypes.Value
+		wantKey tftypes.Value
+	}{
+		{
+			name:    "key not set",
+			config:  config,
+			wantKey: tftypes.NewValue(tftypes.String, nil),
+		},
+		{
+			name:    "key set",
+			config:  map[string]tftypes.Value{"space": str("s"), "block": str("b"), "size": num(24), "idempotency_key": str("k")},
+			wantKey: str("k"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Terraform proposes the config, with the state for the computed
+			// attributes it leaves null.
+			proposed := map[string]tftypes.Value{}
+			for name, value := range state {
+				proposed[name] = value
+			}
+			for name, value := range tt.config {
+				proposed[name] = value
+			}
+
+			objectType := reservationType(t)
+			dynamicValue := func(attrs map[string]tftypes.Value) *tfprotov6.DynamicValue {
+				value, err := tfprotov6.NewDynamicValue(objectType, reservationValue(t, attrs))
+				if err != nil {
+					t.Fatal(err)
+				}
+				return &value
+			}
+
+			server := providerserver.NewProtocol6(New("test")())()
+			resp, err := server.PlanResourceChange(context.Background(), &tfprotov6.PlanResourceChangeRequest{
+				TypeName:         "azureipam_reservation",
+				PriorState:       dynamicValue(state),
+				ProposedNewState: dynamicValue(proposed),
+				Config:           dynamicValue(tt.config),
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			for _, d := range resp.Diagnostics {
+				if d.Severity == tfprotov6.DiagnosticSeverityError {
+					t.Fatalf("PlanResourceChange() error: %s: %s", d.Summary, d.Detail)
+				}
+			}
+
+			if len(resp.RequiresReplace) > 0 {
+				t.Errorf("PlanResourceChange() requires replacement for %v", resp.RequiresReplace)
+			}
+
+			planned, err := resp.PlannedState.Unmarshal(objectType)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var attrs map[string]tftypes.Value
+			if err := planned.As(&attrs); err != nil {
+				t.Fatal(err)
+			}
+			if !attrs["idempotency_key"].Equal(tt.wantKey) {
+				t.Errorf("planned idempotency_key = %v, want %v", attrs["idempotency_key"], tt.wantKey)
+			}
+		})
+	}
+}
@@ -0,0 +1,61 @@
+package provider
+
+import (
+	"context"
+	"terraform-provider-azureipam/internal/gen/data_sources"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = (*subnetPlanDataSource)(nil)
+
+func NewSubnetPlanDataSource() datasource.DataSource {
+	return &subnetPlanDataSource{}
+}
+
+// subnetPlanDataSource is the data source equivalent of the subnet_plan
+// function. It does not call the engine.
+type subnetPlanDataSource struct{}
+
+func (d *subnetPlanDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_subnet_plan"
+}
+
+func (d *subnetPlanDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = data_sources.SubnetPlanDataSourceSchema(ctx)
+}
+
+func (d *subnetPlanDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data data_sources.SubnetPlanModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var subnets map[string]int64
+	resp.Diagnostics.Append(data.Subnets.ElementsAs(ctx, &subnets, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cidrs, freePrefixes, err := planSubnets(data.Cidr.ValueString(), subnets, data.Alignment.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Subnet Plan", err.Error())
+		return
+	}
+
+	cidrsMap, diags := types.MapValueFrom(ctx, types.StringType, cidrs)
+	resp.Diagnostics.Append(diags...)
+	freeList, diags := types.ListValueFrom(ctx, types.StringType, freePrefixes)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Cidrs = cidrsMap
+	data.FreePrefixes = freeList
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
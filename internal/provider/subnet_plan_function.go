@@ -0,0 +1,134 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-azureipam/internal/cidr"
+	"terraform-provider-azureipam/internal/validators"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = (*subnetPlanFunction)(nil)
+
+var subnetPlanAttributeTypes = map[string]attr.Type{
+	"cidrs":         types.MapType{ElemType: types.StringType},
+	"free_prefixes": types.ListType{ElemType: types.StringType},
+}
+
+func NewSubnetPlanFunction() function.Function {
+	return &subnetPlanFunction{}
+}
+
+type subnetPlanFunction struct{}
+
+func (f *subnetPlanFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "subnet_plan"
+}
+
+func (f *subnetPlanFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Divide a CIDR into named Subnets",
+		Description: "Packs Subnets of the requested prefix lengths into `cidr`, largest first and without gaps, and returns an object " +
+			"with the `cidrs` of the Subnets by name and the `free_prefixes` left over. An optional `alignment` prefix length " +
+			"makes every Subnet start on a boundary of that size.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "cidr",
+				Description: "The parent CIDR, given by its network address.",
+				Validators: []function.StringParameterValidator{
+					validators.CidrParameter(),
+				},
+			},
+			function.MapParameter{
+				Name:        "subnets",
+				Description: "The prefix lengths of the Subnets, by name.",
+				ElementType: types.Int64Type,
+			},
+		},
+		VariadicParameter: function.Int64Parameter{
+			Name:        "alignment",
+			Description: "The prefix length every Subnet must be aligned to. At most one may be given.",
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: subnetPlanAttributeTypes,
+		},
+	}
+}
+
+func (f *subnetPlanFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var parent string
+	var subnets map[string]int64
+	var alignments []int64
+
+	resp.Error = req.Arguments.Get(ctx, &parent, &subnets, &alignments)
+	if resp.Error != nil {
+		return
+	}
+
+	var alignment int64
+	switch len(alignments) {
+	case 0:
+	case 1:
+		alignment = alignments[0]
+	default:
+		resp.Error = function.NewArgumentFuncError(2, "At most one alignment may be given.")
+		return
+	}
+
+	cidrs, freePrefixes, err := planSubnets(parent, subnets, alignment)
+	if err != nil {
+		resp.Error = function.NewFuncError(err.Error())
+		return
+	}
+
+	cidrsMap, diags := types.MapValueFrom(ctx, types.StringType, cidrs)
+	freeList, listDiags := types.ListValueFrom(ctx, types.StringType, freePrefixes)
+	diags.Append(listDiags...)
+
+	result, objDiags := types.ObjectValue(subnetPlanAttributeTypes, map[string]attr.Value{
+		"cidrs":         cidrsMap,
+		"free_prefixes": freeList,
+	})
+	diags.Append(objDiags...)
+
+	resp.Error = function.FuncErrorFromDiags(ctx, diags)
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, result)
+}
+
+// planSubnets divides the parent CIDR into the named Subnets. An alignment of
+// zero means the Subnets are not aligned.
+func planSubnets(parent string, subnets map[string]int64, alignment int64) (map[string]string, []string, error) {
+	parentPrefix, err := cidr.Parse(parent)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	lengths := make(map[string]int, len(subnets))
+	for name, bits := range subnets {
+		lengths[name] = int(bits)
+	}
+
+	plan, free, err := cidr.Plan(parentPrefix, lengths, int(alignment))
+	if err != nil {
+		return nil, nil, fmt.Errorf("cannot plan Subnets in %s: %w", parent, err)
+	}
+
+	cidrs := make(map[string]string, len(plan))
+	for name, prefix := range plan {
+		cidrs[name] = prefix.String()
+	}
+
+	freePrefixes := make([]string, len(free))
+	for i, prefix := range free {
+		freePrefixes[i] = prefix.String()
+	}
+
+	return cidrs, freePrefixes, nil
+}
@@ -0,0 +1,74 @@
+// Package validators contains the schema and configuration validators used by
+// the generated schemas and the provider.
+package validators
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var (
+	_ validator.String                  = cidrValidator{}
+	_ function.StringParameterValidator = cidrValidator{}
+)
+
+type cidrValidator struct{}
+
+// Cidr returns a validator which ensures the value is a CIDR given by its
+// network address, e.g. 10.0.0.0/24 rather than 10.0.0.1/24.
+func Cidr() validator.String {
+	return cidrValidator{}
+}
+
+// CidrParameter returns the Cidr validator for a function parameter, so that
+// functions accept the same CIDRs as the schemas.
+func CidrParameter() function.StringParameterValidator {
+	return cidrValidator{}
+}
+
+func (v cidrValidator) Description(_ context.Context) string {
+	return "value must be a CIDR given by its network address"
+}
+
+func (v cidrValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v cidrValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if err := checkCidr(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid CIDR", err.Error())
+	}
+}
+
+func (v cidrValidator) ValidateParameterString(ctx context.Context, req function.StringParameterValidatorRequest, resp *function.StringParameterValidatorResponse) {
+	if req.Value.IsNull() || req.Value.IsUnknown() {
+		return
+	}
+
+	if err := checkCidr(req.Value.ValueString()); err != nil {
+		resp.Error = function.NewArgumentFuncError(req.ArgumentPosition, err.Error())
+	}
+}
+
+// checkCidr returns an error unless value is a CIDR given by its network
+// address.
+func checkCidr(value string) error {
+	prefix, err := netip.ParsePrefix(value)
+	if err != nil {
+		return fmt.Errorf("%q is not a valid CIDR: %s.", value, err)
+	}
+
+	if prefix != prefix.Masked() {
+		return fmt.Errorf("%q is not the network address of its prefix, use %q instead.", value, prefix.Masked())
+	}
+
+	return nil
+}
@@ -0,0 +1,55 @@
+package validators
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestCidr(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   types.String
+		wantErr bool
+	}{
+		{name: "null", value: types.StringNull()},
+		{name: "unknown", value: types.StringUnknown()},
+		{name: "network address", value: types.StringValue("10.0.0.0/24")},
+		{name: "host address", value: types.StringValue("10.0.0.1/24"), wantErr: true},
+		{name: "whole address space", value: types.StringValue("0.0.0.0/0")},
+		{name: "host prefix", value: types.StringValue("10.0.0.1/32")},
+		{name: "IPv6", value: types.StringValue("fd00::/64")},
+		{name: "IPv6 host address", value: types.StringValue("fd00::1/64"), wantErr: true},
+		{name: "IPv6 host prefix", value: types.StringValue("fd00::1/128")},
+		{name: "prefix too long", value: types.StringValue("10.0.0.0/33"), wantErr: true},
+		{name: "missing prefix length", value: types.StringValue("10.0.0.0"), wantErr: true},
+		{name: "empty", value: types.StringValue(""), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := &validator.StringResponse{}
+			Cidr().ValidateString(context.Background(), validator.StringRequest{
+				Path:        path.Root("cidr"),
+				ConfigValue: tt.value,
+			}, resp)
+
+			if got := resp.Diagnostics.HasError(); got != tt.wantErr {
+				t.Errorf("Cidr() error = %t, want %t: %v", got, tt.wantErr, resp.Diagnostics)
+			}
+
+			paramResp := &function.StringParameterValidatorResponse{}
+			CidrParameter().ValidateParameterString(context.Background(), function.StringParameterValidatorRequest{
+				Value: tt.value,
+			}, paramResp)
+
+			if got := paramResp.Error != nil; got != tt.wantErr {
+				t.Errorf("CidrParameter() error = %t, want %t: %v", got, tt.wantErr, paramResp.Error)
+			}
+		})
+	}
+}
@@ -0,0 +1,93 @@
+package validators
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+var (
+	_ datasource.ConfigValidator = exactlyOneOfValidator{}
+	_ resource.ConfigValidator   = exactlyOneOfValidator{}
+)
+
+type exactlyOneOfValidator struct {
+	paths []path.Path
+}
+
+// ExactlyOneOf returns a configuration validator which ensures exactly one of
+// the attributes at paths is configured.
+func ExactlyOneOf(paths ...path.Path) exactlyOneOfValidator {
+	return exactlyOneOfValidator{paths: paths}
+}
+
+func (v exactlyOneOfValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("Exactly one of %s must be configured.", v.names())
+}
+
+func (v exactlyOneOfValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v exactlyOneOfValidator) ValidateDataSource(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	resp.Diagnostics.Append(v.validate(ctx, req.Config)...)
+}
+
+func (v exactlyOneOfValidator) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	resp.Diagnostics.Append(v.validate(ctx, req.Config)...)
+}
+
+func (v exactlyOneOfValidator) validate(ctx context.Context, config tfsdk.Config) diag.Diagnostics {
+	var diags diag.Diagnostics
+	var configured []path.Path
+
+	for _, p := range v.paths {
+		var value attr.Value
+		diags.Append(config.GetAttribute(ctx, p, &value)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		// Unknown values may still turn out to be null, so the check is
+		// deferred until they are known.
+		if value.IsUnknown() {
+			return diags
+		}
+
+		if !value.IsNull() {
+			configured = append(configured, p)
+		}
+	}
+
+	switch {
+	case len(configured) == 0:
+		diags.AddError(
+			"Missing Attribute Configuration",
+			v.Description(ctx),
+		)
+	case len(configured) > 1:
+		diags.AddAttributeError(
+			configured[1],
+			"Invalid Attribute Combination",
+			fmt.Sprintf("Only one of %s can be configured.", v.names()),
+		)
+	}
+
+	return diags
+}
+
+func (v exactlyOneOfValidator) names() string {
+	names := make([]string, len(v.paths))
+	for i, p := range v.paths {
+		names[i] = p.String()
+	}
+
+	return strings.Join(names, ", ")
+}
@@ -0,0 +1,72 @@
+package validators
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestExactlyOneOf(t *testing.T) {
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"cidr":  schema.StringAttribute{Optional: true},
+			"size":  schema.Int64Attribute{Optional: true},
+			"other": schema.StringAttribute{Optional: true},
+		},
+	}
+	objectType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"cidr":  tftypes.String,
+		"size":  tftypes.Number,
+		"other": tftypes.String,
+	}}
+
+	config := func(cidr, size interface{}) tfsdk.Config {
+		return tfsdk.Config{
+			Schema: testSchema,
+			Raw: tftypes.NewValue(objectType, map[string]tftypes.Value{
+				"cidr":  tftypes.NewValue(tftypes.String, cidr),
+				"size":  tftypes.NewValue(tftypes.Number, size),
+				"other": tftypes.NewValue(tftypes.String, "x"),
+			}),
+		}
+	}
+
+	tests := []struct {
+		name     string
+		config   tfsdk.Config
+		wantErr  bool
+		wantPath string
+	}{
+		{name: "neither", config: config(nil, nil), wantErr: true},
+		{name: "first", config: config("10.0.0.0/24", nil)},
+		{name: "second", config: config(nil, 24)},
+		{name: "both", config: config("10.0.0.0/24", 24), wantErr: true, wantPath: "size"},
+		{name: "unknown", config: config(tftypes.UnknownValue, nil)},
+		{name: "unknown with other", config: config(tftypes.UnknownValue, 24)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := &resource.ValidateConfigResponse{}
+			ExactlyOneOf(path.Root("cidr"), path.Root("size")).ValidateResource(context.Background(), resource.ValidateConfigRequest{
+				Config: tt.config,
+			}, resp)
+
+			if got := resp.Diagnostics.HasError(); got != tt.wantErr {
+				t.Fatalf("ExactlyOneOf() error = %t, want %t: %v", got, tt.wantErr, resp.Diagnostics)
+			}
+
+			if tt.wantPath != "" {
+				withPath, ok := resp.Diagnostics.Errors()[0].(interface{ Path() path.Path })
+				if !ok || withPath.Path().String() != tt.wantPath {
+					t.Errorf("ExactlyOneOf() error path = %v, want %s", resp.Diagnostics.Errors()[0], tt.wantPath)
+				}
+			}
+		})
+	}
+}
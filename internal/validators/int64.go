@@ -0,0 +1,115 @@
+package validators
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ validator.Int64 = int64BetweenValidator{}
+
+type int64BetweenValidator struct {
+	min, max int64
+}
+
+// Int64Between returns a validator which ensures the value is between min and
+// max, inclusive.
+func Int64Between(min, max int64) validator.Int64 {
+	return int64BetweenValidator{min: min, max: max}
+}
+
+func (v int64BetweenValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("value must be between %d and %d", v.min, v.max)
+}
+
+func (v int64BetweenValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v int64BetweenValidator) ValidateInt64(ctx context.Context, req validator.Int64Request, resp *validator.Int64Response) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueInt64()
+	if value < v.min || value > v.max {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Attribute Value",
+			fmt.Sprintf("Attribute %s %s, got: %d.", req.Path, v.Description(ctx), value),
+		)
+	}
+}
+
+var _ validator.Int64 = int64OneOfValidator{}
+
+type int64OneOfValidator struct {
+	values []int64
+}
+
+// Int64OneOf returns a validator which ensures the value is one of values.
+func Int64OneOf(values ...int64) validator.Int64 {
+	return int64OneOfValidator{values: values}
+}
+
+func (v int64OneOfValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("value must be one of: %v", v.values)
+}
+
+func (v int64OneOfValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v int64OneOfValidator) ValidateInt64(ctx context.Context, req validator.Int64Request, resp *validator.Int64Response) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueInt64()
+	for _, allowed := range v.values {
+		if value == allowed {
+			return
+		}
+	}
+
+	resp.Diagnostics.AddAttributeError(
+		req.Path,
+		"Invalid Attribute Value",
+		fmt.Sprintf("Attribute %s %s, got: %d.", req.Path, v.Description(ctx), value),
+	)
+}
+
+var _ validator.Int64 = int64AtLeastValidator{}
+
+type int64AtLeastValidator struct {
+	min int64
+}
+
+// Int64AtLeast returns a validator which ensures the value is at least min.
+func Int64AtLeast(min int64) validator.Int64 {
+	return int64AtLeastValidator{min: min}
+}
+
+func (v int64AtLeastValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("value must be at least %d", v.min)
+}
+
+func (v int64AtLeastValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v int64AtLeastValidator) ValidateInt64(ctx context.Context, req validator.Int64Request, resp *validator.Int64Response) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueInt64()
+	if value < v.min {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Attribute Value",
+			fmt.Sprintf("Attribute %s %s, got: %d.", req.Path, v.Description(ctx), value),
+		)
+	}
+}
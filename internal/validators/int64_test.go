@@ -0,0 +1,50 @@
+package validators
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestInt64Validators(t *testing.T) {
+	tests := []struct {
+		name      string
+		validator validator.Int64
+		value     types.Int64
+		wantErr   bool
+	}{
+		{name: "between null", validator: Int64Between(8, 32), value: types.Int64Null()},
+		{name: "between unknown", validator: Int64Between(8, 32), value: types.Int64Unknown()},
+		{name: "between min", validator: Int64Between(8, 32), value: types.Int64Value(8)},
+		{name: "between max", validator: Int64Between(8, 32), value: types.Int64Value(32)},
+		{name: "between below", validator: Int64Between(8, 32), value: types.Int64Value(7), wantErr: true},
+		{name: "between above", validator: Int64Between(8, 32), value: types.Int64Value(33), wantErr: true},
+		{name: "one of null", validator: Int64OneOf(4, 6), value: types.Int64Null()},
+		{name: "one of unknown", validator: Int64OneOf(4, 6), value: types.Int64Unknown()},
+		{name: "one of first", validator: Int64OneOf(4, 6), value: types.Int64Value(4)},
+		{name: "one of last", validator: Int64OneOf(4, 6), value: types.Int64Value(6)},
+		{name: "one of other", validator: Int64OneOf(4, 6), value: types.Int64Value(5), wantErr: true},
+		{name: "at least null", validator: Int64AtLeast(0), value: types.Int64Null()},
+		{name: "at least unknown", validator: Int64AtLeast(0), value: types.Int64Unknown()},
+		{name: "at least min", validator: Int64AtLeast(0), value: types.Int64Value(0)},
+		{name: "at least above", validator: Int64AtLeast(0), value: types.Int64Value(1 << 40)},
+		{name: "at least below", validator: Int64AtLeast(0), value: types.Int64Value(-1), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := &validator.Int64Response{}
+			tt.validator.ValidateInt64(context.Background(), validator.Int64Request{
+				Path:        path.Root("size"),
+				ConfigValue: tt.value,
+			}, resp)
+
+			if got := resp.Diagnostics.HasError(); got != tt.wantErr {
+				t.Errorf("ValidateInt64() error = %t, want %t: %v", got, tt.wantErr, resp.Diagnostics)
+			}
+		})
+	}
+}
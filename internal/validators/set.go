@@ -0,0 +1,51 @@
+package validators
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ validator.Set = setEachStringValidator{}
+
+type setEachStringValidator struct {
+	validator validator.String
+}
+
+// SetEachString returns a validator which applies v to every element of a
+// set of strings.
+func SetEachString(v validator.String) validator.Set {
+	return setEachStringValidator{validator: v}
+}
+
+func (v setEachStringValidator) Description(ctx context.Context) string {
+	return "each element: " + v.validator.Description(ctx)
+}
+
+func (v setEachStringValidator) MarkdownDescription(ctx context.Context) string {
+	return "each element: " + v.validator.MarkdownDescription(ctx)
+}
+
+func (v setEachStringValidator) ValidateSet(ctx context.Context, req validator.SetRequest, resp *validator.SetResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	for _, element := range req.ConfigValue.Elements() {
+		value, ok := element.(types.String)
+		if !ok {
+			continue
+		}
+
+		elementResp := &validator.StringResponse{}
+		v.validator.ValidateString(ctx, validator.StringRequest{
+			Path:           req.Path.AtSetValue(value),
+			PathExpression: req.PathExpression.AtSetValue(value),
+			ConfigValue:    value,
+			Config:         req.Config,
+		}, elementResp)
+
+		resp.Diagnostics.Append(elementResp.Diagnostics...)
+	}
+}
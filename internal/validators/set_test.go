@@ -0,0 +1,52 @@
+package validators
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestSetEachString(t *testing.T) {
+	set := func(values ...string) types.Set {
+		elements := make([]attr.Value, len(values))
+		for i, v := range values {
+			elements[i] = types.StringValue(v)
+		}
+		return types.SetValueMust(types.StringType, elements)
+	}
+
+	tests := []struct {
+		name       string
+		value      types.Set
+		wantErrors int
+	}{
+		{name: "null", value: types.SetNull(types.StringType)},
+		{name: "unknown", value: types.SetUnknown(types.StringType)},
+		{name: "empty", value: set()},
+		{name: "valid", value: set("10.0.0.0/24", "fd00::/64")},
+		{name: "one invalid", value: set("10.0.0.0/24", "10.0.0.1/24"), wantErrors: 1},
+		{name: "all invalid", value: set("10.0.0.1/24", "nope"), wantErrors: 2},
+		{
+			name:  "unknown element",
+			value: types.SetValueMust(types.StringType, []attr.Value{types.StringUnknown(), types.StringValue("10.0.0.0/24")}),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := &validator.SetResponse{}
+			SetEachString(Cidr()).ValidateSet(context.Background(), validator.SetRequest{
+				Path:        path.Root("exclude_cidrs"),
+				ConfigValue: tt.value,
+			}, resp)
+
+			if got := resp.Diagnostics.ErrorsCount(); got != tt.wantErrors {
+				t.Errorf("SetEachString() errors = %d, want %d: %v", got, tt.wantErrors, resp.Diagnostics)
+			}
+		})
+	}
+}
@@ -0,0 +1,48 @@
+package validators
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestStringValidators(t *testing.T) {
+	tests := []struct {
+		name      string
+		validator validator.String
+		value     types.String
+		wantErr   bool
+	}{
+		{name: "one of null", validator: StringOneOf("ordered", "most_free"), value: types.StringNull()},
+		{name: "one of unknown", validator: StringOneOf("ordered", "most_free"), value: types.StringUnknown()},
+		{name: "one of first", validator: StringOneOf("ordered", "most_free"), value: types.StringValue("ordered")},
+		{name: "one of last", validator: StringOneOf("ordered", "most_free"), value: types.StringValue("most_free")},
+		{name: "one of case", validator: StringOneOf("ordered", "most_free"), value: types.StringValue("Ordered"), wantErr: true},
+		{name: "one of empty", validator: StringOneOf("ordered", "most_free"), value: types.StringValue(""), wantErr: true},
+		{name: "guid null", validator: Guid(), value: types.StringNull()},
+		{name: "guid unknown", validator: Guid(), value: types.StringUnknown()},
+		{name: "guid lower case", validator: Guid(), value: types.StringValue("0f3c7a2e-1b4d-4e5f-8a9b-0c1d2e3f4a5b")},
+		{name: "guid upper case", validator: Guid(), value: types.StringValue("0F3C7A2E-1B4D-4E5F-8A9B-0C1D2E3F4A5B")},
+		{name: "guid braces", validator: Guid(), value: types.StringValue("{0f3c7a2e-1b4d-4e5f-8a9b-0c1d2e3f4a5b}"), wantErr: true},
+		{name: "guid short", validator: Guid(), value: types.StringValue("0f3c7a2e-1b4d-4e5f-8a9b-0c1d2e3f4a5"), wantErr: true},
+		{name: "guid not hex", validator: Guid(), value: types.StringValue("0f3c7a2e-1b4d-4e5f-8a9b-0c1d2e3f4a5g"), wantErr: true},
+		{name: "guid empty", validator: Guid(), value: types.StringValue(""), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := &validator.StringResponse{}
+			tt.validator.ValidateString(context.Background(), validator.StringRequest{
+				Path:        path.Root("value"),
+				ConfigValue: tt.value,
+			}, resp)
+
+			if got := resp.Diagnostics.HasError(); got != tt.wantErr {
+				t.Errorf("ValidateString() error = %t, want %t: %v", got, tt.wantErr, resp.Diagnostics)
+			}
+		})
+	}
+}